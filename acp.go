@@ -0,0 +1,163 @@
+package goamzparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+// acpRequestBody is the JSON body of a board continuation request.
+type acpRequestBody struct {
+	FaceoutKataName string   `json:"faceoutkataname"`
+	IDs             []string `json:"ids"`
+	Indexes         []int    `json:"indexes"`
+	LinkParameters  string   `json:"linkparameters"`
+	Offset          string   `json:"offset"`
+	ReftagPrefix    string   `json:"reftagprefix"`
+}
+
+// NewAcpRequest builds the request a best sellers or new releases board
+// page sends to lazy load the ranks that are not rendered in its grid.
+func (p *Parser) NewAcpRequest(doc *html.Node, region string) (*http.Request, error) {
+	parser := p.GetBoardParser(region)
+	prefix := RegionPrefix(region)
+	if parser == nil || prefix == "" {
+		return nil, fmt.Errorf("'%v' error, unsupported region", region)
+	}
+
+	acpPath, err := parser.ParseAcpPath(doc)
+	if err != nil {
+		return nil, err
+	}
+	acpParam, err := parser.ParseAcpParam(doc)
+	if err != nil {
+		return nil, err
+	}
+	reftag, err := parser.ParseReftag(doc)
+	if err != nil {
+		return nil, err
+	}
+	recsList, err := parser.ParseRecsList(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := 0
+	if value, err := parser.ParseOffset(doc); err == nil {
+		offset, _ = strconv.Atoi(value)
+	}
+
+	var recs []json.RawMessage
+	if err := json.Unmarshal([]byte(recsList), &recs); err != nil {
+		return nil, fmt.Errorf("'%v' error, %v", "data-client-recs-list", err)
+	}
+
+	rendered := 0
+	if nodes, err := utils.FindNodes(doc, `//div[@id='gridItemRoot']`, true); err == nil {
+		rendered = len(nodes)
+	}
+	if rendered >= len(recs) {
+		return nil, errors.ErrorNotFoundRecsList
+	}
+
+	body := acpRequestBody{
+		FaceoutKataName: "GeneralFaceout",
+		Offset:          strconv.Itoa(offset + rendered),
+		ReftagPrefix:    reftag,
+	}
+	for i, rec := range recs[rendered:] {
+		body.IDs = append(body.IDs, string(rec))
+		body.Indexes = append(body.Indexes, offset+rendered+i)
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(prefix + strings.TrimSuffix(acpPath, "/") + "/nextPage")
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = acpParam
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/html, application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Origin", prefix)
+	req.Header.Set("X-Amz-Acp-Params", acpParam)
+	req.Header.Set("X-Requested-With", "XMLHttpRequest")
+	return req, nil
+}
+
+// ParseAcpResponse parses the ranked items from the response of a request
+// built by NewAcpRequest. The response is either plain HTML or a list of
+// "&&&" separated JSON arrays carrying HTML fragments.
+func (p *Parser) ParseAcpResponse(body []byte, region string) ([]*model.BoardItem, error) {
+	parser := p.GetBoardParser(region)
+	if parser == nil {
+		return nil, fmt.Errorf("'%v' error, unsupported region", region)
+	}
+
+	var fragments []string
+	for _, chunk := range strings.Split(string(body), "&&&") {
+		chunk = strings.TrimSpace(chunk)
+		if chunk == "" {
+			continue
+		}
+
+		var values []interface{}
+		if err := json.Unmarshal([]byte(chunk), &values); err != nil {
+			fragments = append(fragments, chunk)
+			continue
+		}
+		for _, value := range values {
+			if s, ok := value.(string); ok && strings.Contains(s, "<") {
+				fragments = append(fragments, s)
+			}
+		}
+	}
+
+	doc, err := htmlquery.Parse(strings.NewReader(strings.Join(fragments, "\n")))
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := utils.FindNodes(doc, `//div[@id='gridItemRoot']`, true)
+	if err != nil {
+		return nil, err
+	}
+	return parseBoardItems(parser, nodes), nil
+}
+
+// parseBoardItems parses the typed items from the given board grid items.
+func parseBoardItems(parser BoardParser, nodes []*html.Node) []*model.BoardItem {
+	items := make([]*model.BoardItem, 0, len(nodes))
+	for _, node := range nodes {
+		item := &model.BoardItem{}
+		item.ASIN, _ = parser.ParseASIN(node)
+		item.Title, _ = parser.ParseTitle(node)
+		item.Price, _ = parser.ParsePrice(node)
+		item.Star, _ = parser.ParseStar(node)
+		item.Rating, _ = parser.ParseRating(node)
+		if rank, err := parser.ParseRank(node); err == nil {
+			item.Rank, _ = strconv.Atoi(strings.TrimSpace(rank))
+		}
+		items = append(items, item)
+	}
+	return items
+}
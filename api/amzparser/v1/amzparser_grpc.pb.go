@@ -0,0 +1,368 @@
+// Regenerate the Go code with `buf generate` from the repository root.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: amzparser/v1/amzparser.proto
+
+package amzparserv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AmzParser_ParseProduct_FullMethodName  = "/amzparser.v1.AmzParser/ParseProduct"
+	AmzParser_ParseSearch_FullMethodName   = "/amzparser.v1.AmzParser/ParseSearch"
+	AmzParser_ParseCategory_FullMethodName = "/amzparser.v1.AmzParser/ParseCategory"
+	AmzParser_ParseSeller_FullMethodName   = "/amzparser.v1.AmzParser/ParseSeller"
+	AmzParser_ParseBoard_FullMethodName    = "/amzparser.v1.AmzParser/ParseBoard"
+	AmzParser_ParseReviews_FullMethodName  = "/amzparser.v1.AmzParser/ParseReviews"
+	AmzParser_ParseBatch_FullMethodName    = "/amzparser.v1.AmzParser/ParseBatch"
+)
+
+// AmzParserClient is the client API for AmzParser service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AmzParser parses saved Amazon pages with the parsers registered in
+// goamzparser.Parser. Each RPC mirrors one of the parser interfaces.
+type AmzParserClient interface {
+	// ParseProduct parses a product detail page (ProductParser).
+	ParseProduct(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ProductResult, error)
+	// ParseSearch parses a keyword search page (KeywordParser).
+	ParseSearch(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*SearchResult, error)
+	// ParseCategory parses a category page (CategoryParser).
+	ParseCategory(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*CategoryResult, error)
+	// ParseSeller parses a seller storefront page (SellerParser).
+	ParseSeller(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*SellerResult, error)
+	// ParseBoard parses a best sellers or new releases page (BoardParser).
+	ParseBoard(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*BoardResult, error)
+	// ParseReviews parses a customer reviews page (AmzReviewParser).
+	ParseReviews(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ReviewResult, error)
+	// ParseBatch parses a stream of pages, answering each request with one
+	// response carrying the same id.
+	ParseBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchRequest, BatchResponse], error)
+}
+
+type amzParserClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAmzParserClient(cc grpc.ClientConnInterface) AmzParserClient {
+	return &amzParserClient{cc}
+}
+
+func (c *amzParserClient) ParseProduct(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ProductResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResult)
+	err := c.cc.Invoke(ctx, AmzParser_ParseProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amzParserClient) ParseSearch(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*SearchResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResult)
+	err := c.cc.Invoke(ctx, AmzParser_ParseSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amzParserClient) ParseCategory(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*CategoryResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResult)
+	err := c.cc.Invoke(ctx, AmzParser_ParseCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amzParserClient) ParseSeller(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*SellerResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SellerResult)
+	err := c.cc.Invoke(ctx, AmzParser_ParseSeller_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amzParserClient) ParseBoard(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*BoardResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardResult)
+	err := c.cc.Invoke(ctx, AmzParser_ParseBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amzParserClient) ParseReviews(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ReviewResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResult)
+	err := c.cc.Invoke(ctx, AmzParser_ParseReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amzParserClient) ParseBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchRequest, BatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AmzParser_ServiceDesc.Streams[0], AmzParser_ParseBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BatchRequest, BatchResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AmzParser_ParseBatchClient = grpc.BidiStreamingClient[BatchRequest, BatchResponse]
+
+// AmzParserServer is the server API for AmzParser service.
+// All implementations must embed UnimplementedAmzParserServer
+// for forward compatibility.
+//
+// AmzParser parses saved Amazon pages with the parsers registered in
+// goamzparser.Parser. Each RPC mirrors one of the parser interfaces.
+type AmzParserServer interface {
+	// ParseProduct parses a product detail page (ProductParser).
+	ParseProduct(context.Context, *ParseRequest) (*ProductResult, error)
+	// ParseSearch parses a keyword search page (KeywordParser).
+	ParseSearch(context.Context, *ParseRequest) (*SearchResult, error)
+	// ParseCategory parses a category page (CategoryParser).
+	ParseCategory(context.Context, *ParseRequest) (*CategoryResult, error)
+	// ParseSeller parses a seller storefront page (SellerParser).
+	ParseSeller(context.Context, *ParseRequest) (*SellerResult, error)
+	// ParseBoard parses a best sellers or new releases page (BoardParser).
+	ParseBoard(context.Context, *ParseRequest) (*BoardResult, error)
+	// ParseReviews parses a customer reviews page (AmzReviewParser).
+	ParseReviews(context.Context, *ParseRequest) (*ReviewResult, error)
+	// ParseBatch parses a stream of pages, answering each request with one
+	// response carrying the same id.
+	ParseBatch(grpc.BidiStreamingServer[BatchRequest, BatchResponse]) error
+	mustEmbedUnimplementedAmzParserServer()
+}
+
+// UnimplementedAmzParserServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAmzParserServer struct{}
+
+func (UnimplementedAmzParserServer) ParseProduct(context.Context, *ParseRequest) (*ProductResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseProduct not implemented")
+}
+func (UnimplementedAmzParserServer) ParseSearch(context.Context, *ParseRequest) (*SearchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseSearch not implemented")
+}
+func (UnimplementedAmzParserServer) ParseCategory(context.Context, *ParseRequest) (*CategoryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseCategory not implemented")
+}
+func (UnimplementedAmzParserServer) ParseSeller(context.Context, *ParseRequest) (*SellerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseSeller not implemented")
+}
+func (UnimplementedAmzParserServer) ParseBoard(context.Context, *ParseRequest) (*BoardResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseBoard not implemented")
+}
+func (UnimplementedAmzParserServer) ParseReviews(context.Context, *ParseRequest) (*ReviewResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseReviews not implemented")
+}
+func (UnimplementedAmzParserServer) ParseBatch(grpc.BidiStreamingServer[BatchRequest, BatchResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ParseBatch not implemented")
+}
+func (UnimplementedAmzParserServer) mustEmbedUnimplementedAmzParserServer() {}
+func (UnimplementedAmzParserServer) testEmbeddedByValue()                   {}
+
+// UnsafeAmzParserServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AmzParserServer will
+// result in compilation errors.
+type UnsafeAmzParserServer interface {
+	mustEmbedUnimplementedAmzParserServer()
+}
+
+func RegisterAmzParserServer(s grpc.ServiceRegistrar, srv AmzParserServer) {
+	// If the following call pancis, it indicates UnimplementedAmzParserServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AmzParser_ServiceDesc, srv)
+}
+
+func _AmzParser_ParseProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmzParserServer).ParseProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmzParser_ParseProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmzParserServer).ParseProduct(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmzParser_ParseSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmzParserServer).ParseSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmzParser_ParseSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmzParserServer).ParseSearch(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmzParser_ParseCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmzParserServer).ParseCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmzParser_ParseCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmzParserServer).ParseCategory(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmzParser_ParseSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmzParserServer).ParseSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmzParser_ParseSeller_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmzParserServer).ParseSeller(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmzParser_ParseBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmzParserServer).ParseBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmzParser_ParseBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmzParserServer).ParseBoard(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmzParser_ParseReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmzParserServer).ParseReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmzParser_ParseReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmzParserServer).ParseReviews(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmzParser_ParseBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AmzParserServer).ParseBatch(&grpc.GenericServerStream[BatchRequest, BatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AmzParser_ParseBatchServer = grpc.BidiStreamingServer[BatchRequest, BatchResponse]
+
+// AmzParser_ServiceDesc is the grpc.ServiceDesc for AmzParser service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AmzParser_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "amzparser.v1.AmzParser",
+	HandlerType: (*AmzParserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ParseProduct",
+			Handler:    _AmzParser_ParseProduct_Handler,
+		},
+		{
+			MethodName: "ParseSearch",
+			Handler:    _AmzParser_ParseSearch_Handler,
+		},
+		{
+			MethodName: "ParseCategory",
+			Handler:    _AmzParser_ParseCategory_Handler,
+		},
+		{
+			MethodName: "ParseSeller",
+			Handler:    _AmzParser_ParseSeller_Handler,
+		},
+		{
+			MethodName: "ParseBoard",
+			Handler:    _AmzParser_ParseBoard_Handler,
+		},
+		{
+			MethodName: "ParseReviews",
+			Handler:    _AmzParser_ParseReviews_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ParseBatch",
+			Handler:       _AmzParser_ParseBatch_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "amzparser/v1/amzparser.proto",
+}
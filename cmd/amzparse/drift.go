@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+)
+
+// exitDrift is the exit status of the drift command when a field's fill
+// rate dropped beyond the threshold.
+const exitDrift = 3
+
+// runDrift extracts the given pages and compares the per field fill rates
+// with the baseline, or writes them as the new baseline with -update.
+func runDrift(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("amzparse drift", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	baseline := flags.String("baseline", "baseline.json", "the fill rates baseline file")
+	threshold := flags.Float64("threshold", 0.1, "the fill rate drop, from 0 to 1, that raises an alert")
+	update := flags.Bool("update", false, "write the fill rates of the pages as the new baseline")
+	region := flags.String("region", "", "force the region, e.g. en-us, en-gb, de-de, fr-fr")
+	pageType := flags.String("type", "", "force the page type")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: amzparse drift [flags] file-or-dir...\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	files, err := findFiles(flags.Args())
+	if err != nil {
+		fmt.Fprintf(stderr, "amzparse: %v\n", err)
+		return 1
+	}
+
+	status := 0
+	rates := goamzparser.NewFillRates()
+	parser := goamzparser.NewParser()
+	for _, file := range files {
+		result, err := parseFile(parser, file, *region, *pageType, nil)
+		if err != nil {
+			fmt.Fprintf(stderr, "amzparse: %v: %v\n", file, err)
+			status = 1
+			rates.Fail(requestedPage(file, *region, *pageType))
+			continue
+		}
+		rates.Add(result)
+	}
+
+	if *update {
+		data, err := json.MarshalIndent(rates, "", "  ")
+		if err == nil {
+			err = os.WriteFile(*baseline, append(data, '\n'), 0o644)
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "amzparse: %v\n", err)
+			return 1
+		}
+		return status
+	}
+
+	data, err := os.ReadFile(*baseline)
+	if err != nil {
+		fmt.Fprintf(stderr, "amzparse: %v\n", err)
+		return 1
+	}
+	base := &goamzparser.FillRates{}
+	if err := json.Unmarshal(data, base); err != nil {
+		fmt.Fprintf(stderr, "amzparse: %v: %v\n", *baseline, err)
+		return 1
+	}
+
+	alerts := goamzparser.DetectDrift(base, rates, *threshold)
+	for _, alert := range alerts {
+		fmt.Fprintln(stdout, alert)
+	}
+	if len(alerts) > 0 {
+		return exitDrift
+	}
+	return status
+}
+
+// requestedPage returns the region and page type a page that failed to
+// parse is counted under, detecting them from the page when not forced.
+// Undetectable values are "unknown".
+func requestedPage(file, region, pageType string) (string, string) {
+	if region != "" && pageType != "" {
+		return strings.ToLower(region), pageType
+	}
+
+	doc, err := htmlquery.LoadDoc(file)
+	if region == "" {
+		region = "unknown"
+		if err == nil {
+			region, _ = goamzparser.ParseRegion(doc)
+		}
+	}
+	if pageType == "" {
+		pageType = "unknown"
+		if err == nil {
+			pageType, _ = goamzparser.DetectPageType(doc)
+		}
+	}
+	return strings.ToLower(region), pageType
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+)
+
+// findFiles expands the given paths into HTML files, searching
+// directories recursively.
+func findFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			ext := strings.ToLower(filepath.Ext(file))
+			if !d.IsDir() && (ext == ".html" || ext == ".htm") {
+				files = append(files, file)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// parseFile extracts the record of the given HTML file. The selector
+// diagnostics are added to report if it is not nil.
+func parseFile(parser *goamzparser.Parser, file, region, pageType string, report *goamzparser.SelectorReport) (*goamzparser.Result, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	doc, err := htmlquery.Parse(f)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return parser.Extract(doc, region, pageType)
+	}
+
+	result, diagnostics, err := parser.Diagnose(doc, region, pageType)
+	if err != nil {
+		return nil, err
+	}
+	report.Add(diagnostics)
+	return result, nil
+}
@@ -0,0 +1,172 @@
+// Command amzparse extracts the record of saved Amazon pages.
+//
+// Usage:
+//
+//	amzparse [flags] file-or-dir...
+//	amzparse serve [-addr :8080] [-grpc-addr :9090]
+//	amzparse schema
+//	amzparse drift [-baseline file] [-threshold 0.1] [-update] file-or-dir...
+//	amzparse sanitize [-o file] file
+//
+// The region and page type of each page are detected from the document
+// unless forced with -region and -type. Directories are searched
+// recursively for .html and .htm files. The json format writes an array
+// with one entry per parsed file and ndjson one line per file. The csv and
+// parquet formats export one table, for the page type of the first file,
+// with the columns of the export package. With -trace the XPath expressions tried by the
+// parsers, their match counts and winners are aggregated over the batch
+// into a selector report.
+//
+// The serve command exposes the parsers over HTTP: POST /parse/{pageType}
+// with the raw, optionally gzip compressed, HTML as body and an optional
+// region query parameter returns the record as JSON. GET /healthz reports
+// liveness and GET /metrics the request and field failure counters. With
+// -grpc-addr the AmzParser gRPC service is served as well.
+//
+// The schema command prints the JSON Schema of the json and ndjson output.
+//
+// The drift command extracts a batch of pages and compares the fill rate
+// of each field, per region and page type, with a baseline written by a
+// previous run with -update. It prints an alert for each field whose fill
+// rate dropped by more than -threshold and exits with status 3 if there
+// was any. Pages that cannot be parsed at all lower the fill rate of the
+// "page" field.
+//
+// The sanitize command writes a shareable copy of a page: personal and
+// session data are replaced and the markup the parsers do not read is
+// removed, verifying that the page still parses to the same record.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) > 0 && args[0] == "serve" {
+		return runServe(args[1:], stderr)
+	}
+	if len(args) > 0 && args[0] == "schema" {
+		return runSchema(stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == "drift" {
+		return runDrift(args[1:], stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == "sanitize" {
+		return runSanitize(args[1:], stdout, stderr)
+	}
+
+	flags := flag.NewFlagSet("amzparse", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	region := flags.String("region", "", "force the region, e.g. en-us, en-gb, de-de, fr-fr")
+	pageType := flags.String("type", "", "force the page type: "+strings.Join(goamzparser.PageTypes, ", "))
+	format := flags.String("format", "json", "output format: json, ndjson, csv, parquet or table")
+	fields := flags.String("fields", "", "comma separated list of fields, or csv and parquet columns, to output")
+	output := flags.String("o", "", "write the output to the given file instead of stdout")
+	trace := flags.String("trace", "", "write the selector report of the batch as JSON to the given file")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: amzparse [flags] file-or-dir...\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(stderr, "amzparse: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		stdout = f
+	}
+
+	w, err := newWriter(*format, stdout, splitFields(*fields))
+	if err != nil {
+		fmt.Fprintf(stderr, "amzparse: %v\n", err)
+		return 2
+	}
+
+	files, err := findFiles(flags.Args())
+	if err != nil {
+		fmt.Fprintf(stderr, "amzparse: %v\n", err)
+		return 1
+	}
+
+	var report *goamzparser.SelectorReport
+	if *trace != "" {
+		report = goamzparser.NewSelectorReport()
+	}
+
+	status := 0
+	parser := goamzparser.NewParser()
+	for _, file := range files {
+		result, err := parseFile(parser, file, *region, *pageType, report)
+		if err != nil {
+			fmt.Fprintf(stderr, "amzparse: %v: %v\n", file, err)
+			status = 1
+			continue
+		}
+		if err := w.Write(file, result); err != nil {
+			fmt.Fprintf(stderr, "amzparse: %v: %v\n", file, err)
+			status = 1
+		}
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintf(stderr, "amzparse: %v\n", err)
+		return 1
+	}
+	if report != nil {
+		if err := writeReport(*trace, report); err != nil {
+			fmt.Fprintf(stderr, "amzparse: %v\n", err)
+			return 1
+		}
+	}
+	return status
+}
+
+// writeReport writes the selector report as JSON to the given file.
+func writeReport(file string, report *goamzparser.SelectorReport) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, append(data, '\n'), 0o644)
+}
+
+// runSchema prints the JSON Schema of the results.
+func runSchema(stdout, stderr io.Writer) int {
+	schema, err := goamzparser.JSONSchema()
+	if err != nil {
+		fmt.Fprintf(stderr, "amzparse: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "%s\n", schema)
+	return 0
+}
+
+// splitFields splits a comma separated list of field names.
+func splitFields(s string) []string {
+	var fields []string
+	for _, field := range strings.Split(s, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
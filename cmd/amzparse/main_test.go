@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	searchFile  = "../../testdata/en-us/search/basic.html"
+	productFile = "../../testdata/en-us/product/basic.html"
+)
+
+func TestRunArgs(t *testing.T) {
+	tests := []struct {
+		args   []string
+		status int
+	}{
+		{nil, 2},
+		{[]string{"-unknown", searchFile}, 2},
+		{[]string{"-format", "xml", searchFile}, 2},
+		{[]string{"missing.html"}, 1},
+		{[]string{"-region", "ja-jp", searchFile}, 1},
+		{[]string{"-type", "board", "-format", "table", searchFile}, 0},
+		{[]string{searchFile}, 0},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		if status := run(test.args, &stdout, &stderr); status != test.status {
+			t.Errorf("Unexpected status for %v: %v, want %v: %v\n", test.args, status, test.status, stderr.String())
+		}
+	}
+}
+
+func TestRunJSON(t *testing.T) {
+	for _, files := range [][]string{{searchFile}, {searchFile, productFile}} {
+		var stdout, stderr bytes.Buffer
+		if status := run(append([]string{"-fields", "asin,keyword"}, files...), &stdout, &stderr); status != 0 {
+			t.Fatalf("Unexpected status %v: %v\n", status, stderr.String())
+		}
+
+		var outputs []output
+		if err := json.Unmarshal(stdout.Bytes(), &outputs); err != nil {
+			t.Fatalf("Error decoding output: %s\n", err.Error())
+		}
+		if len(outputs) != len(files) || outputs[0].File != searchFile || outputs[0].PageType != "search" ||
+			outputs[0].Record["keyword"] != "usb c cable" || outputs[0].Record["refinements"] != nil {
+			t.Errorf("Unexpected output for %v: %+v\n", files, outputs)
+		}
+	}
+}
+
+func TestRunPartialFailure(t *testing.T) {
+	dir := t.TempDir()
+	broken := filepath.Join(dir, "broken.html")
+	if err := os.WriteFile(broken, []byte(`<html><body>nothing</body></html>`), 0o644); err != nil {
+		t.Fatalf("Error writing page: %s\n", err.Error())
+	}
+
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"-format", "ndjson", broken, searchFile}, &stdout, &stderr); status != 1 {
+		t.Errorf("Unexpected status %v\n", status)
+	}
+	if lines := strings.Split(strings.TrimSpace(stdout.String()), "\n"); len(lines) != 1 || !strings.Contains(stderr.String(), "broken.html") {
+		t.Errorf("Unexpected output: %q, %q\n", stdout.String(), stderr.String())
+	}
+}
+
+func TestRunCSV(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "items.csv")
+
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"-format", "csv", "-fields", "asin,position", "-o", out, searchFile}, &stdout, &stderr); status != 0 {
+		t.Fatalf("Unexpected status %v: %v\n", status, stderr.String())
+	}
+	f, err := os.Open(out)
+	if err != nil {
+		t.Fatalf("Error opening output: %s\n", err.Error())
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatalf("Error reading output: %s\n", err.Error())
+	}
+	if len(rows) != 4 || strings.Join(rows[0], ",") != "asin,position" || strings.Join(rows[3], ",") != "B0CABLE003,3" {
+		t.Errorf("Unexpected rows: %v\n", rows)
+	}
+}
+
+func TestRunTable(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"-format", "table", "-fields", "asin,title", productFile}, &stdout, &stderr); status != 0 {
+		t.Fatalf("Unexpected status %v: %v\n", status, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "== "+productFile+" (en-us, product)") || !strings.Contains(stdout.String(), "asin") {
+		t.Errorf("Unexpected table: %v\n", stdout.String())
+	}
+}
+
+func TestRunSchema(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"schema"}, &stdout, &stderr); status != 0 {
+		t.Fatalf("Unexpected status %v: %v\n", status, stderr.String())
+	}
+	if !json.Valid(stdout.Bytes()) {
+		t.Errorf("Schema is not valid JSON\n")
+	}
+}
+
+func TestRunDrift(t *testing.T) {
+	dir := t.TempDir()
+	baseline := filepath.Join(dir, "baseline.json")
+	broken := filepath.Join(dir, "broken.html")
+	if err := os.WriteFile(broken, []byte(`<html lang="en-us"><body>nothing</body></html>`), 0o644); err != nil {
+		t.Fatalf("Error writing page: %s\n", err.Error())
+	}
+
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"drift", "-baseline", baseline, "-update", productFile}, &stdout, &stderr); status != 0 {
+		t.Fatalf("Unexpected status %v: %v\n", status, stderr.String())
+	}
+
+	stdout.Reset()
+	if status := run([]string{"drift", "-baseline", baseline, productFile, broken}, &stdout, &stderr); status != exitDrift {
+		t.Fatalf("Unexpected status %v: %v\n", status, stderr.String())
+	}
+	if stdout.String() != "en-us unknown page: fill rate dropped from 100.0% to 0.0%\n" {
+		t.Errorf("Unexpected alerts: %q\n", stdout.String())
+	}
+}
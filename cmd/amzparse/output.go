@@ -0,0 +1,307 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+	"github.com/microsuite/go-amz-parser/export"
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+// writer writes the results of the parsed files.
+type writer interface {
+	Write(file string, result *goamzparser.Result) error
+	Flush() error
+}
+
+func newWriter(format string, w io.Writer, fields []string) (writer, error) {
+	switch format {
+	case "json":
+		return &jsonWriter{w: w, fields: fields}, nil
+	case "ndjson":
+		return &jsonWriter{w: w, fields: fields, lines: true}, nil
+	case "csv", "parquet":
+		return &exportWriter{w: w, format: format, columns: fields}, nil
+	case "table":
+		return &tableWriter{w: tabwriter.NewWriter(w, 0, 4, 2, ' ', 0), fields: fields}, nil
+	default:
+		return nil, fmt.Errorf("'%v' error, unsupported format", format)
+	}
+}
+
+// output is a result as written by the json and ndjson formats.
+type output struct {
+	File             string                   `json:"file"`
+	SchemaVersion    string                   `json:"schema_version"`
+	Region           string                   `json:"region"`
+	PageType         string                   `json:"page_type"`
+	Record           map[string]interface{}   `json:"record"`
+	Errors           []goamzparser.FieldError `json:"errors,omitempty"`
+	ValidationErrors []goamzparser.FieldError `json:"validation_errors,omitempty"`
+	Confidence       float64                  `json:"confidence"`
+	FieldConfidence  map[string]float64       `json:"field_confidence"`
+}
+
+type jsonWriter struct {
+	w       io.Writer
+	fields  []string
+	lines   bool
+	outputs []*output
+}
+
+func (w *jsonWriter) Write(file string, result *goamzparser.Result) error {
+	record, err := toMap(result.Record)
+	if err != nil {
+		return err
+	}
+
+	out := &output{
+		File:             file,
+		SchemaVersion:    result.SchemaVersion,
+		Region:           result.Region,
+		PageType:         result.PageType,
+		Record:           selectFields(record, w.fields),
+		Errors:           result.Errors,
+		ValidationErrors: result.ValidationErrors,
+		Confidence:       result.Confidence,
+		FieldConfidence:  result.FieldConfidence,
+	}
+	if !w.lines {
+		w.outputs = append(w.outputs, out)
+		return nil
+	}
+	return json.NewEncoder(w.w).Encode(out)
+}
+
+func (w *jsonWriter) Flush() error {
+	if w.lines {
+		return nil
+	}
+
+	outputs := w.outputs
+	if outputs == nil {
+		outputs = []*output{}
+	}
+	enc := json.NewEncoder(w.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(outputs)
+}
+
+// exportWriter writes the results as a CSV or Parquet table. The table is
+// created for the page type of the first result.
+type exportWriter struct {
+	w       io.Writer
+	format  string
+	columns []string
+	csv     *export.CSVWriter
+	parquet *export.ParquetWriter
+}
+
+func (w *exportWriter) Write(file string, result *goamzparser.Result) error {
+	var err error
+	switch {
+	case w.format == "csv" && w.csv == nil:
+		w.csv, err = export.NewCSVWriter(w.w, result.PageType, w.columns...)
+	case w.format == "parquet" && w.parquet == nil:
+		w.parquet, err = export.NewParquetWriter(w.w, result.PageType, w.columns...)
+	}
+	if err != nil {
+		return err
+	}
+
+	if w.csv != nil {
+		return w.csv.Write(result)
+	}
+	return w.parquet.Write(result)
+}
+
+func (w *exportWriter) Flush() error {
+	if w.csv != nil {
+		return w.csv.Flush()
+	}
+	if w.parquet != nil {
+		return w.parquet.Close()
+	}
+	return nil
+}
+
+type tableWriter struct {
+	w      *tabwriter.Writer
+	fields []string
+}
+
+func (w *tableWriter) Write(file string, result *goamzparser.Result) error {
+	columns, rows, err := tabulate(result, w.fields)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w.w, "== %v (%v, %v)\n", file, result.Region, result.PageType)
+	if _, ok := result.Record.(*model.ProductRecord); ok {
+		for _, row := range rows {
+			for _, column := range columns {
+				fmt.Fprintf(w.w, "%v\t%v\n", column, truncate(cell(row[column])))
+			}
+		}
+	} else {
+		fmt.Fprintln(w.w, strings.Join(columns, "\t"))
+		for _, row := range rows {
+			values := make([]string, 0, len(columns))
+			for _, column := range columns {
+				values = append(values, truncate(cell(row[column])))
+			}
+			fmt.Fprintln(w.w, strings.Join(values, "\t"))
+		}
+	}
+	for _, e := range result.Errors {
+		fmt.Fprintf(w.w, "error\t%v: %v\n", e.Field, e.Error)
+	}
+	fmt.Fprintln(w.w)
+	return w.w.Flush()
+}
+
+func (w *tableWriter) Flush() error {
+	return w.w.Flush()
+}
+
+// tabulate returns the columns and rows of a result for the table format:
+// one row for a product page, one row per item or review for the other
+// page types.
+func tabulate(result *goamzparser.Result, fields []string) ([]string, []map[string]interface{}, error) {
+	var rows []interface{}
+	var row reflect.Type
+	switch record := result.Record.(type) {
+	case *model.ProductRecord:
+		rows, row = []interface{}{record}, reflect.TypeOf(model.ProductRecord{})
+	case *model.ListingRecord:
+		for _, item := range record.Items {
+			rows = append(rows, item)
+		}
+		row = reflect.TypeOf(model.ListingItem{})
+	case *model.ReviewRecord:
+		for _, review := range record.Reviews {
+			rows = append(rows, review)
+		}
+		row = reflect.TypeOf(model.Review{})
+	default:
+		return nil, nil, fmt.Errorf("'%T' error, unsupported record", result.Record)
+	}
+
+	columns := fields
+	if len(columns) == 0 {
+		columns = jsonFields(row)
+	}
+
+	maps := make([]map[string]interface{}, 0, len(rows))
+	for _, r := range rows {
+		m, err := toMap(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		maps = append(maps, m)
+	}
+	return columns, maps, nil
+}
+
+// jsonFields returns the json names of the fields of a struct type in
+// declaration order.
+func jsonFields(t reflect.Type) []string {
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// toMap converts v into its generic JSON representation.
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// selectFields keeps the selected fields of a record and of the objects of
+// its lists, such as the items of a listing or the reviews of a review page.
+func selectFields(record map[string]interface{}, fields []string) map[string]interface{} {
+	if len(fields) == 0 {
+		return record
+	}
+
+	selected := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		selected[field] = true
+	}
+
+	out := make(map[string]interface{})
+	for key, value := range record {
+		if selected[key] {
+			out[key] = value
+			continue
+		}
+
+		list, ok := value.([]interface{})
+		if !ok || len(list) == 0 {
+			continue
+		}
+		if _, ok := list[0].(map[string]interface{}); !ok {
+			continue
+		}
+
+		found := false
+		elems := make([]interface{}, 0, len(list))
+		for _, elem := range list {
+			m, _ := elem.(map[string]interface{})
+			filtered := make(map[string]interface{})
+			for k, v := range m {
+				if selected[k] {
+					filtered[k] = v
+					found = true
+				}
+			}
+			elems = append(elems, filtered)
+		}
+		if found {
+			out[key] = elems
+		}
+	}
+	return out
+}
+
+// cell formats a value as a single CSV or table cell.
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64, bool:
+		return fmt.Sprint(v)
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// truncate shortens long values for the human readable table.
+func truncate(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if r := []rune(s); len(r) > 80 {
+		return string(r[:77]) + "..."
+	}
+	return s
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+	"github.com/microsuite/go-amz-parser/sanitize"
+)
+
+// runSanitize writes the sanitized HTML of a saved page.
+func runSanitize(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("amzparse sanitize", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	region := flags.String("region", "", "force the region, e.g. en-us, en-gb, de-de, fr-fr")
+	pageType := flags.String("type", "", "force the page type")
+	output := flags.String("o", "", "write the sanitized page to the given file instead of stdout")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: amzparse sanitize [flags] file\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	file := flags.Arg(0)
+	page, err := os.ReadFile(file)
+	if err != nil {
+		fmt.Fprintf(stderr, "amzparse: %v\n", err)
+		return 1
+	}
+	sanitized, err := sanitize.Sanitize(goamzparser.NewParser(), page, *region, *pageType)
+	if err != nil {
+		fmt.Fprintf(stderr, "amzparse: %v: %v\n", file, err)
+		return 1
+	}
+
+	if *output != "" {
+		err = os.WriteFile(*output, sanitized, 0o644)
+	} else {
+		_, err = stdout.Write(sanitized)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "amzparse: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(stderr, "%v: %v -> %v bytes\n", file, len(page), len(sanitized))
+	return 0
+}
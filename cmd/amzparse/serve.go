@@ -0,0 +1,308 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antchfx/htmlquery"
+	"google.golang.org/grpc"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+	amzparserv1 "github.com/microsuite/go-amz-parser/api/amzparser/v1"
+	"github.com/microsuite/go-amz-parser/grpcserver"
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+// runServe runs the HTTP parsing service.
+func runServe(args []string, stderr io.Writer) int {
+	flags := flag.NewFlagSet("amzparse serve", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	addr := flags.String("addr", ":8080", "address to listen on")
+	grpcAddr := flags.String("grpc-addr", "", "address to serve the gRPC API on, disabled if empty")
+	maxBody := flags.Int64("max-body", 32<<20, "maximum size in bytes of a decompressed request body or gRPC page")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	logger := log.New(stderr, "amzparse: ", log.LstdFlags)
+	if *grpcAddr != "" {
+		lis, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			logger.Print(err)
+			return 1
+		}
+
+		parser := grpcserver.NewServer(goamzparser.NewParser())
+		parser.MaxSize = *maxBody
+		srv := grpc.NewServer(grpc.MaxRecvMsgSize(int(*maxBody)))
+		amzparserv1.RegisterAmzParserServer(srv, parser)
+		go func() {
+			logger.Printf("serving gRPC on %v", *grpcAddr)
+			if err := srv.Serve(lis); err != nil {
+				logger.Print(err)
+			}
+		}()
+	}
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           newServer(*maxBody),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       time.Minute,
+		WriteTimeout:      time.Minute,
+		IdleTimeout:       2 * time.Minute,
+	}
+	logger.Printf("listening on %v", *addr)
+	if err := srv.ListenAndServe(); err != nil {
+		logger.Print(err)
+		return 1
+	}
+	return 0
+}
+
+// server parses the pages posted to /parse/{pageType}.
+type server struct {
+	parser  *goamzparser.Parser
+	maxBody int64
+	metrics *metrics
+	mux     *http.ServeMux
+}
+
+func newServer(maxBody int64) *server {
+	s := &server{
+		parser:  goamzparser.NewParser(),
+		maxBody: maxBody,
+		metrics: newMetrics(),
+		mux:     http.NewServeMux(),
+	}
+	s.mux.HandleFunc("POST /parse/{pageType}", s.handleParse)
+	s.mux.HandleFunc("GET /healthz", s.handleHealthz)
+	s.mux.HandleFunc("GET /metrics", s.handleMetrics)
+	return s
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// handleParse parses the raw, optionally gzip compressed, HTML body.
+// The region is detected from the document when not given.
+func (s *server) handleParse(w http.ResponseWriter, r *http.Request) {
+	pageType := r.PathValue("pageType")
+	region := strings.ToLower(r.URL.Query().Get("region"))
+	if pageType == "auto" {
+		pageType = ""
+	}
+
+	body, err := s.readBody(w, r)
+	if errors.Is(err, errBodyTooLarge) {
+		s.fail(w, pageType, region, http.StatusRequestEntityTooLarge, err)
+		return
+	}
+	if err != nil {
+		s.fail(w, pageType, region, http.StatusBadRequest, err)
+		return
+	}
+
+	doc, err := htmlquery.Parse(bytes.NewReader(body))
+	if err != nil {
+		s.fail(w, pageType, region, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := s.parser.Extract(doc, region, pageType)
+	if err != nil {
+		s.fail(w, pageType, region, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	s.metrics.observe(result)
+	writeJSON(w, http.StatusOK, result)
+}
+
+// errBodyTooLarge is returned for bodies exceeding the maximum size, before
+// or after decompression.
+var errBodyTooLarge = fmt.Errorf("'%v' error, request body too large", "body")
+
+// readBody returns the request body, decompressing gzip bodies.
+func (s *server) readBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	br := bufio.NewReader(http.MaxBytesReader(w, r.Body, s.maxBody))
+	magic, _ := br.Peek(2)
+	var body io.Reader = br
+	if strings.EqualFold(r.Header.Get("Content-Encoding"), "gzip") || (len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, tooLarge(err)
+		}
+		defer zr.Close()
+		body = zr
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, s.maxBody+1))
+	if err != nil {
+		return nil, tooLarge(err)
+	}
+	if int64(len(data)) > s.maxBody {
+		return nil, errBodyTooLarge
+	}
+	return data, nil
+}
+
+// tooLarge maps the error of a body exceeding http.MaxBytesReader to
+// errBodyTooLarge.
+func tooLarge(err error) error {
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return errBodyTooLarge
+	}
+	return err
+}
+
+func (s *server) fail(w http.ResponseWriter, pageType, region string, code int, err error) {
+	s.metrics.request(pageTypeLabel(pageType), regionLabel(region), code)
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
+
+func (s *server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	io.WriteString(w, "ok\n")
+}
+
+func (s *server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	s.metrics.writeTo(w)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+var indexRegex = regexp.MustCompile(`\[\d+\]`)
+
+// invalidLabel replaces unsupported page types and regions in the metric
+// labels, which would otherwise be unbounded.
+const invalidLabel = "invalid"
+
+// pageTypeLabel returns the metric label of a requested page type.
+func pageTypeLabel(pageType string) string {
+	if pageType == "" {
+		return pageType
+	}
+	for _, supported := range goamzparser.PageTypes {
+		if pageType == supported {
+			return pageType
+		}
+	}
+	return invalidLabel
+}
+
+// regionLabel returns the metric label of a requested region.
+func regionLabel(region string) string {
+	if region == "" || goamzparser.RegionPrefix(region) != "" {
+		return region
+	}
+	return invalidLabel
+}
+
+// metrics counts the requests and the parsed and failed fields per page
+// type and region. The failure rate of a field is its failures divided by
+// its records, or by its items for the fields of list items.
+type metrics struct {
+	mu       sync.Mutex
+	requests map[[3]string]int
+	records  map[[2]string]int
+	items    map[[2]string]int
+	failures map[[3]string]int
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests: make(map[[3]string]int),
+		records:  make(map[[2]string]int),
+		items:    make(map[[2]string]int),
+		failures: make(map[[3]string]int),
+	}
+}
+
+func (m *metrics) request(pageType, region string, code int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[[3]string{pageType, region, fmt.Sprint(code)}]++
+}
+
+func (m *metrics) observe(result *goamzparser.Result) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := [2]string{result.PageType, result.Region}
+	m.requests[[3]string{result.PageType, result.Region, "200"}]++
+	m.records[key]++
+	switch record := result.Record.(type) {
+	case *model.ListingRecord:
+		m.items[key] += len(record.Items)
+	case *model.ReviewRecord:
+		m.items[key] += len(record.Reviews)
+	}
+
+	for _, e := range result.Errors {
+		field := indexRegex.ReplaceAllString(e.Field, "")
+		m.failures[[3]string{result.PageType, result.Region, field}]++
+	}
+}
+
+func (m *metrics) writeTo(w io.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fmt.Fprintln(w, "# HELP amzparse_requests_total Parse requests by page type, region and status code.")
+	fmt.Fprintln(w, "# TYPE amzparse_requests_total counter")
+	for _, k := range sortedKeys(m.requests) {
+		fmt.Fprintf(w, "amzparse_requests_total{page_type=%q,region=%q,code=%q} %v\n", k[0], k[1], k[2], m.requests[k])
+	}
+
+	fmt.Fprintln(w, "# HELP amzparse_records_total Parsed records by page type and region.")
+	fmt.Fprintln(w, "# TYPE amzparse_records_total counter")
+	for _, k := range sortedKeys(m.records) {
+		fmt.Fprintf(w, "amzparse_records_total{page_type=%q,region=%q} %v\n", k[0], k[1], m.records[k])
+	}
+
+	fmt.Fprintln(w, "# HELP amzparse_items_total Parsed list items or reviews by page type and region.")
+	fmt.Fprintln(w, "# TYPE amzparse_items_total counter")
+	for _, k := range sortedKeys(m.items) {
+		fmt.Fprintf(w, "amzparse_items_total{page_type=%q,region=%q} %v\n", k[0], k[1], m.items[k])
+	}
+
+	fmt.Fprintln(w, "# HELP amzparse_field_failures_total Fields that failed to parse by page type, region and field.")
+	fmt.Fprintln(w, "# TYPE amzparse_field_failures_total counter")
+	for _, k := range sortedKeys(m.failures) {
+		fmt.Fprintf(w, "amzparse_field_failures_total{page_type=%q,region=%q,field=%q} %v\n", k[0], k[1], k[2], m.failures[k])
+	}
+}
+
+// sortedKeys returns the keys of m in a stable order.
+func sortedKeys[K [2]string | [3]string](m map[K]int) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+	return keys
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const searchPage = `<html lang="en-us"><body>
+	<input id="twotabsearchtextbox" value="usb cable"/>
+	<div class="s-result-item" data-asin="B0ABCDEFGH" data-index="1" data-uuid="a">
+		<div><h2 class="a-text-normal"><span>Cable A</span></h2></div>
+	</div>
+</body></html>`
+
+func TestServeParse(t *testing.T) {
+	srv := httptest.NewServer(newServer(1 << 20))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(searchPage))
+	zw.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/parse/search?region=en-us", &buf)
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Error posting page: %s\n", err.Error())
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Region   string `json:"region"`
+		PageType string `json:"page_type"`
+		Record   struct {
+			Keyword string `json:"keyword"`
+			Items   []struct {
+				ASIN string `json:"asin"`
+			} `json:"items"`
+		} `json:"record"`
+		Errors []struct {
+			Field string `json:"field"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Error decoding response: %s\n", err.Error())
+	}
+	if resp.StatusCode != http.StatusOK || result.Record.Keyword != "usb cable" || len(result.Record.Items) != 1 ||
+		result.Record.Items[0].ASIN != "B0ABCDEFGH" || len(result.Errors) == 0 {
+		t.Errorf("Unexpected response: %v %+v\n", resp.StatusCode, result)
+	}
+
+	resp, err = http.Post(srv.URL+"/parse/search?region=ja-jp", "text/html", strings.NewReader(searchPage))
+	if err != nil {
+		t.Fatalf("Error posting page: %s\n", err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Unexpected status for an unsupported region: %v\n", resp.StatusCode)
+	}
+
+	resp, err = http.Get(srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("Error getting metrics: %s\n", err.Error())
+	}
+	defer resp.Body.Close()
+
+	var metrics bytes.Buffer
+	metrics.ReadFrom(resp.Body)
+	for _, line := range []string{
+		`amzparse_requests_total{page_type="search",region="en-us",code="200"} 1`,
+		`amzparse_requests_total{page_type="search",region="invalid",code="422"} 1`,
+		`amzparse_items_total{page_type="search",region="en-us"} 1`,
+		`amzparse_field_failures_total{page_type="search",region="en-us",field="items.price"} 1`,
+	} {
+		if !strings.Contains(metrics.String(), line) {
+			t.Errorf("Missing metric %v in:\n%v\n", line, metrics.String())
+		}
+	}
+}
+
+func TestServeBodyTooLarge(t *testing.T) {
+	srv := httptest.NewServer(newServer(1 << 10))
+	defer srv.Close()
+
+	var bomb bytes.Buffer
+	zw := gzip.NewWriter(&bomb)
+	zw.Write(bytes.Repeat([]byte("<p>cable</p>"), 1<<10))
+	zw.Close()
+
+	tests := []struct {
+		path string
+		body io.Reader
+	}{
+		{"/parse/search?region=en-us", strings.NewReader(strings.Repeat("x", 2<<10))},
+		{"/parse/search?region=en-us", &bomb},
+		{"/parse/unknown-type?region=xx-yy", strings.NewReader(strings.Repeat("x", 2<<10))},
+	}
+	for _, test := range tests {
+		resp, err := http.Post(srv.URL+test.path, "text/html", test.body)
+		if err != nil {
+			t.Fatalf("Error posting page: %s\n", err.Error())
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusRequestEntityTooLarge {
+			t.Errorf("Unexpected status for %v: %v\n", test.path, resp.StatusCode)
+		}
+	}
+
+	resp, err := http.Get(srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("Error getting metrics: %s\n", err.Error())
+	}
+	defer resp.Body.Close()
+
+	var metrics bytes.Buffer
+	metrics.ReadFrom(resp.Body)
+	for _, line := range []string{
+		`amzparse_requests_total{page_type="search",region="en-us",code="413"} 2`,
+		`amzparse_requests_total{page_type="invalid",region="invalid",code="413"} 1`,
+	} {
+		if !strings.Contains(metrics.String(), line) {
+			t.Errorf("Missing metric %v in:\n%v\n", line, metrics.String())
+		}
+	}
+}
+
+func TestServeHealthz(t *testing.T) {
+	srv := httptest.NewServer(newServer(1 << 20))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/healthz")
+	if err != nil {
+		t.Fatalf("Error getting healthz: %s\n", err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Unexpected status: %v\n", resp.StatusCode)
+	}
+}
@@ -0,0 +1,104 @@
+package goamzparser
+
+import (
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+// Diagnostics is the selector trace of a single extracted page: each
+// parser method called, the XPath expressions it tried with their match
+// counts and the ones that won.
+type Diagnostics struct {
+	Region   string        `json:"region"`
+	PageType string        `json:"page_type"`
+	Calls    []*utils.Call `json:"calls"`
+}
+
+// Diagnose extracts the record like Extract while tracing the expressions
+// evaluated by the parsers. Tracing is scoped to the given document, so
+// documents extracted concurrently are unaffected.
+func (p *Parser) Diagnose(doc *html.Node, region, pageType string) (*Result, *Diagnostics, error) {
+	trace := utils.StartTrace(doc)
+	result, err := p.Extract(doc, region, pageType)
+	utils.StopTrace(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, &Diagnostics{Region: result.Region, PageType: result.PageType, Calls: trace.Calls()}, nil
+}
+
+// SelectorStats are the aggregated counters of an expression of a parser
+// method across a batch of pages.
+type SelectorStats struct {
+	Region   string `json:"region"`
+	PageType string `json:"page_type"`
+	Method   string `json:"method"`
+	Expr     string `json:"expr"`
+	// Tried is the number of calls that evaluated the expression.
+	Tried int `json:"tried"`
+	// Matched is the number of calls in which it selected nodes.
+	Matched int `json:"matched"`
+	// Won is the number of calls in which it was a winning expression.
+	Won int `json:"won"`
+}
+
+// SelectorReport aggregates the diagnostics of a batch of pages. The
+// expressions of a method are kept in the order they were first tried.
+type SelectorReport struct {
+	Pages     int              `json:"pages"`
+	Selectors []*SelectorStats `json:"selectors"`
+
+	index map[[4]string]*SelectorStats
+}
+
+// NewSelectorReport returns an empty report.
+func NewSelectorReport() *SelectorReport {
+	return &SelectorReport{index: make(map[[4]string]*SelectorStats)}
+}
+
+// Add adds the diagnostics of a page to the report.
+func (r *SelectorReport) Add(d *Diagnostics) {
+	r.Pages++
+	for _, call := range d.Calls {
+		seen := make(map[string]bool)
+		for _, attempt := range call.Attempts {
+			stats := r.stats(d.Region, d.PageType, call.Method, attempt.Expr)
+			if seen[attempt.Expr] {
+				continue
+			}
+			seen[attempt.Expr] = true
+
+			stats.Tried++
+			if attempt.Matches > 0 {
+				stats.Matched++
+			}
+			if call.Won(attempt.Expr) {
+				stats.Won++
+			}
+		}
+	}
+}
+
+// Dead returns the expressions that never selected nodes, which are
+// candidates to be fixed or pruned.
+func (r *SelectorReport) Dead() []*SelectorStats {
+	var dead []*SelectorStats
+	for _, stats := range r.Selectors {
+		if stats.Matched == 0 {
+			dead = append(dead, stats)
+		}
+	}
+	return dead
+}
+
+func (r *SelectorReport) stats(region, pageType, method, expr string) *SelectorStats {
+	key := [4]string{region, pageType, method, expr}
+	stats, ok := r.index[key]
+	if !ok {
+		stats = &SelectorStats{Region: region, PageType: pageType, Method: method, Expr: expr}
+		r.index[key] = stats
+		r.Selectors = append(r.Selectors, stats)
+	}
+	return stats
+}
@@ -0,0 +1,198 @@
+package goamzparser
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+var indexRegex = regexp.MustCompile(`\[\d+\]`)
+
+// FieldFill counts how often a field was parsed and how often it was filled.
+type FieldFill struct {
+	Tried  int `json:"tried"`
+	Filled int `json:"filled"`
+}
+
+// Rate returns the share of the parsed fields that were filled.
+func (f *FieldFill) Rate() float64 {
+	if f.Tried == 0 {
+		return 0
+	}
+	return float64(f.Filled) / float64(f.Tried)
+}
+
+// FillGroup are the fill rates of the pages of a region and page type.
+// Fields of list items and reviews are named without their index, e.g.
+// "items[].price". The "page" field counts the pages that could be
+// extracted at all, Failed those that could not.
+type FillGroup struct {
+	Region   string                `json:"region"`
+	PageType string                `json:"page_type"`
+	Pages    int                   `json:"pages"`
+	Failed   int                   `json:"failed"`
+	Fields   map[string]*FieldFill `json:"fields"`
+}
+
+// pageField is the field counting the extracted pages of a group.
+const pageField = "page"
+
+// FillRates are the per field fill rates of a batch of extracted pages,
+// grouped by region and page type. Stored as JSON they are the baseline
+// DetectDrift compares later batches with.
+type FillRates struct {
+	SchemaVersion string       `json:"schema_version"`
+	Groups        []*FillGroup `json:"groups"`
+}
+
+// NewFillRates returns empty fill rates.
+func NewFillRates() *FillRates {
+	return &FillRates{SchemaVersion: model.SchemaVersion}
+}
+
+// Add counts the fields of the given result. A field is filled if it was
+// parsed, is not an absent optional field and passed validation.
+func (f *FillRates) Add(result *Result) {
+	group := f.addGroup(result.Region, result.PageType)
+	group.Pages++
+	group.fill(pageField, true)
+
+	failed := make(map[string]int)
+	for _, e := range result.Errors {
+		failed[e.Field]++
+	}
+	for _, e := range result.ValidationErrors {
+		failed[e.Field]++
+	}
+	for _, name := range result.fields {
+		filled := failed[name] == 0 && !result.missing[name]
+		if failed[name] > 0 {
+			failed[name]--
+		}
+		group.fill(indexRegex.ReplaceAllString(name, "[]"), filled)
+	}
+}
+
+// Fail counts a page of the requested region and page type that could
+// not be extracted, e.g. because its layout is no longer recognized.
+func (f *FillRates) Fail(region, pageType string) {
+	group := f.addGroup(region, pageType)
+	group.Pages++
+	group.Failed++
+	group.fill(pageField, false)
+}
+
+// addGroup returns the fill rates of the given region and page type,
+// adding them if missing.
+func (f *FillRates) addGroup(region, pageType string) *FillGroup {
+	if group := f.Group(region, pageType); group != nil {
+		return group
+	}
+
+	group := &FillGroup{Region: region, PageType: pageType, Fields: make(map[string]*FieldFill)}
+	f.Groups = append(f.Groups, group)
+	sort.Slice(f.Groups, func(i, j int) bool {
+		if f.Groups[i].Region != f.Groups[j].Region {
+			return f.Groups[i].Region < f.Groups[j].Region
+		}
+		return f.Groups[i].PageType < f.Groups[j].PageType
+	})
+	return group
+}
+
+// fill counts a try of the named field.
+func (g *FillGroup) fill(name string, filled bool) {
+	fill, ok := g.Fields[name]
+	if !ok {
+		fill = &FieldFill{}
+		g.Fields[name] = fill
+	}
+	fill.Tried++
+	if filled {
+		fill.Filled++
+	}
+}
+
+// Group returns the fill rates of the given region and page type, or nil.
+func (f *FillRates) Group(region, pageType string) *FillGroup {
+	for _, group := range f.Groups {
+		if group.Region == region && group.PageType == pageType {
+			return group
+		}
+	}
+	return nil
+}
+
+// DriftAlert reports a field whose fill rate dropped against the baseline.
+type DriftAlert struct {
+	Region   string  `json:"region"`
+	PageType string  `json:"page_type"`
+	Field    string  `json:"field"`
+	Baseline float64 `json:"baseline"`
+	Current  float64 `json:"current"`
+}
+
+func (a DriftAlert) String() string {
+	return fmt.Sprintf("%v %v %v: fill rate dropped from %.1f%% to %.1f%%",
+		a.Region, a.PageType, a.Field, a.Baseline*100, a.Current*100)
+}
+
+// DetectDrift compares the fill rates of a batch with the baseline and
+// returns an alert for each field whose rate dropped by more than
+// threshold, e.g. 0.1 for ten percentage points. A field of the baseline
+// that was not parsed in the batch counts as never filled. Regions and
+// page types absent from the batch are not compared, those absent from the
+// baseline are only checked for pages that could not be extracted.
+func DetectDrift(baseline, current *FillRates, threshold float64) []DriftAlert {
+	var alerts []DriftAlert
+	for _, group := range current.Groups {
+		page, ok := group.Fields[pageField]
+		if !ok || baseline.Group(group.Region, group.PageType) != nil || 1-page.Rate() <= threshold {
+			continue
+		}
+		alerts = append(alerts, DriftAlert{
+			Region:   group.Region,
+			PageType: group.PageType,
+			Field:    pageField,
+			Baseline: 1,
+			Current:  page.Rate(),
+		})
+	}
+
+	for _, base := range baseline.Groups {
+		group := current.Group(base.Region, base.PageType)
+		if group == nil || group.Pages == 0 {
+			continue
+		}
+
+		for field, fill := range base.Fields {
+			rate := 0.0
+			if now, ok := group.Fields[field]; ok {
+				rate = now.Rate()
+			}
+			if fill.Rate()-rate > threshold {
+				alerts = append(alerts, DriftAlert{
+					Region:   base.Region,
+					PageType: base.PageType,
+					Field:    field,
+					Baseline: fill.Rate(),
+					Current:  rate,
+				})
+			}
+		}
+	}
+
+	sort.Slice(alerts, func(i, j int) bool {
+		a, b := alerts[i], alerts[j]
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		if a.PageType != b.PageType {
+			return a.PageType < b.PageType
+		}
+		return a.Field < b.Field
+	})
+	return alerts
+}
@@ -11,6 +11,7 @@ var (
 	ErrorNotFoundTitle               = fmt.Errorf("not found title")
 	ErrorNotFoundLanguage            = fmt.Errorf("not found lang")
 	ErrorNotFoundNextPage            = fmt.Errorf("not found next page")
+	ErrorNotFoundPrevPage            = fmt.Errorf("not found previous page")
 	ErrorNotFoundRecsList            = fmt.Errorf("not found recs list")
 	ErrorNotFoundReftag              = fmt.Errorf("not found ref tag")
 	ErrorNotFoundOffset              = fmt.Errorf("not found offset")
@@ -39,4 +40,23 @@ var (
 	ErrorNotFoundKeyword             = fmt.Errorf("not found keyword")
 	ErrorNotFoundReviewer            = fmt.Errorf("not found reviewer")
 	ErrorNotFoundReviewerLink        = fmt.Errorf("not found reviewer link")
+	ErrorNotFoundPurchase            = fmt.Errorf("not found verified purchase")
+	ErrorNotFoundReviewDate          = fmt.Errorf("not found review date")
+	ErrorNotFoundReview              = fmt.Errorf("not found review")
+	ErrorNotFoundContent             = fmt.Errorf("not found content")
+	ErrorNotFoundVariant             = fmt.Errorf("not found variant")
+	ErrorNotFoundReviewID            = fmt.Errorf("not found review id")
+	ErrorNotFoundPermalink           = fmt.Errorf("not found permalink")
+	ErrorEmptyValue                  = fmt.Errorf("empty value")
+	ErrorNotFoundCoupon              = fmt.Errorf("not found coupon")
+	ErrorNotFoundDeal                = fmt.Errorf("not found deal")
+	ErrorNotFoundRefinements         = fmt.Errorf("not found refinements")
+	ErrorNotFoundHistogram           = fmt.Errorf("not found rating histogram")
+	ErrorNotFoundReviewInsights      = fmt.Errorf("not found review insights")
+	ErrorInvalidASIN                 = fmt.Errorf("invalid asin")
+	ErrorInvalidStar                 = fmt.Errorf("invalid star")
+	ErrorInvalidRating               = fmt.Errorf("invalid rating")
+	ErrorInvalidPrice                = fmt.Errorf("invalid price")
+	ErrorInvalidURL                  = fmt.Errorf("invalid url")
+	ErrorInvalidPage                 = fmt.Errorf("invalid page")
 )
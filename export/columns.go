@@ -0,0 +1,335 @@
+// Package export writes extracted results as CSV or Apache Parquet tables.
+//
+// Product pages export one row per page, search, category, seller and
+// board pages one row per item, and review pages one row per review.
+// Nested values are flattened: the category hierarchy into a path and
+// fixed levels, the rating histogram into one column per star.
+package export
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+// Type is the type of a column.
+type Type int
+
+const (
+	String Type = iota
+	Int
+	Float
+	Bool
+)
+
+// categoryLevels is the number of flattened category hierarchy levels.
+const categoryLevels = 5
+
+// Column is a typed column of an exported table. Missing values, such as
+// empty strings or fields that failed to parse, are written as NULL or as
+// an empty CSV field.
+type Column struct {
+	Name  string
+	Type  Type
+	value func(r *row) interface{}
+}
+
+// valueOf returns the value of the column for the given row, nil if it is
+// missing.
+func (c Column) valueOf(r *row) interface{} {
+	v := c.value(r)
+	if s, ok := v.(string); ok && s == "" {
+		return nil
+	}
+	return v
+}
+
+// row is the source of one exported row.
+type row struct {
+	result  *goamzparser.Result
+	product *model.ProductRecord
+	listing *model.ListingRecord
+	item    *model.ListingItem
+	review  *model.Review
+}
+
+// table returns the table a page type is exported to.
+func table(pageType string) (string, error) {
+	switch pageType {
+	case goamzparser.PageProduct:
+		return "product", nil
+	case goamzparser.PageSearch, goamzparser.PageCategory, goamzparser.PageSeller, goamzparser.PageBoard:
+		return "listing", nil
+	case goamzparser.PageReview:
+		return "review", nil
+	default:
+		return "", fmt.Errorf("'%v' error, unsupported page type", pageType)
+	}
+}
+
+// Columns returns all columns of the table of the given page type.
+func Columns(pageType string) ([]Column, error) {
+	t, err := table(pageType)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []Column{
+		{"region", String, func(r *row) interface{} { return r.result.Region }},
+		{"page_type", String, func(r *row) interface{} { return r.result.PageType }},
+	}
+	switch t {
+	case "product":
+		columns = append(columns, productColumns()...)
+	case "listing":
+		columns = append(columns, listingColumns()...)
+	case "review":
+		columns = append(columns, reviewColumns()...)
+	}
+	return columns, nil
+}
+
+// selectColumns returns the named columns of the table of the given page
+// type, or all columns if no name is given.
+func selectColumns(pageType string, names []string) ([]Column, error) {
+	columns, err := Columns(pageType)
+	if err != nil || len(names) == 0 {
+		return columns, err
+	}
+
+	byName := make(map[string]Column, len(columns))
+	for _, column := range columns {
+		byName[column.Name] = column
+	}
+
+	selected := make([]Column, 0, len(names))
+	for _, name := range names {
+		column, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("'%v' error, unknown column for %v pages", name, pageType)
+		}
+		selected = append(selected, column)
+	}
+	return selected, nil
+}
+
+// rows returns the rows of the given result for the given table.
+func rows(t string, result *goamzparser.Result) ([]*row, error) {
+	if rt, err := table(result.PageType); err != nil || rt != t {
+		return nil, fmt.Errorf("'%v' error, cannot export %v pages to a %v table", result.PageType, result.PageType, t)
+	}
+
+	switch record := result.Record.(type) {
+	case *model.ProductRecord:
+		return []*row{{result: result, product: record}}, nil
+	case *model.ListingRecord:
+		out := make([]*row, 0, len(record.Items))
+		for _, item := range record.Items {
+			out = append(out, &row{result: result, listing: record, item: item})
+		}
+		return out, nil
+	case *model.ReviewRecord:
+		out := make([]*row, 0, len(record.Reviews))
+		for _, review := range record.Reviews {
+			out = append(out, &row{result: result, review: review})
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("'%T' error, unsupported record", result.Record)
+	}
+}
+
+func productColumns() []Column {
+	p := func(f func(p *model.ProductRecord) interface{}) func(r *row) interface{} {
+		return func(r *row) interface{} { return f(r.product) }
+	}
+
+	columns := []Column{
+		{"asin", String, p(func(p *model.ProductRecord) interface{} { return p.ASIN })},
+		{"title", String, p(func(p *model.ProductRecord) interface{} { return p.Title })},
+		{"brand", String, p(func(p *model.ProductRecord) interface{} { return p.Brand })},
+		{"price", String, p(func(p *model.ProductRecord) interface{} { return p.Price })},
+		{"price_amount", Float, p(func(p *model.ProductRecord) interface{} { return amount(priceInfo(p).Price) })},
+		{"currency", String, p(func(p *model.ProductRecord) interface{} { return currency(priceInfo(p).Price) })},
+		{"list_price_amount", Float, p(func(p *model.ProductRecord) interface{} { return amount(priceInfo(p).ListPrice) })},
+		{"savings_percent", Int, p(func(p *model.ProductRecord) interface{} { return positive(priceInfo(p).SavingsPercent) })},
+		{"deal_badge", String, p(func(p *model.ProductRecord) interface{} { return priceInfo(p).DealBadge })},
+		{"coupon", String, p(func(p *model.ProductRecord) interface{} { return p.Coupon })},
+		{"prime_price", String, p(func(p *model.ProductRecord) interface{} { return p.PrimePrice })},
+		{"star", Float, p(func(p *model.ProductRecord) interface{} { return number(p.Star) })},
+		{"rating", Int, p(func(p *model.ProductRecord) interface{} { return integer(p.Rating) })},
+		{"img", String, p(func(p *model.ProductRecord) interface{} { return p.Img })},
+		{"sold_by", String, p(func(p *model.ProductRecord) interface{} { return p.SoldBy })},
+		{"dispatch_from", String, p(func(p *model.ProductRecord) interface{} { return p.DispatchFrom })},
+		{"seller_id", String, p(func(p *model.ProductRecord) interface{} { return p.SellerID })},
+		{"category_id", String, p(func(p *model.ProductRecord) interface{} { return p.CategoryID })},
+		{"has_cart", Bool, p(func(p *model.ProductRecord) interface{} { return p.HasCart })},
+		{"color", String, p(func(p *model.ProductRecord) interface{} { return p.Color })},
+		{"size", String, p(func(p *model.ProductRecord) interface{} { return p.Size })},
+		{"delivery_time", String, p(func(p *model.ProductRecord) interface{} { return p.DeliveryTime })},
+		{"fastest_delivery", String, p(func(p *model.ProductRecord) interface{} { return p.FastestDelivery })},
+		{"product_dimensions", String, p(func(p *model.ProductRecord) interface{} { return p.ProductDimensions })},
+		{"package_dimensions", String, p(func(p *model.ProductRecord) interface{} { return p.PackageDimensions })},
+		{"product_weight", String, p(func(p *model.ProductRecord) interface{} { return p.ProductWeight })},
+		{"package_weight", String, p(func(p *model.ProductRecord) interface{} { return p.PackageWeight })},
+		{"first_avail_date", String, p(func(p *model.ProductRecord) interface{} { return p.FirstAvailDate })},
+		{"specs", String, p(func(p *model.ProductRecord) interface{} { return strings.Join(p.Specs, " | ") })},
+		{"description", String, p(func(p *model.ProductRecord) interface{} { return p.Description })},
+		{"category_path", String, p(func(p *model.ProductRecord) interface{} { return strings.Join(p.CategoryHierarchy, " > ") })},
+	}
+
+	for i := 0; i < categoryLevels; i++ {
+		level := i
+		columns = append(columns, Column{fmt.Sprintf("category_%v", level+1), String, p(func(p *model.ProductRecord) interface{} {
+			if level < len(p.CategoryHierarchy) {
+				return p.CategoryHierarchy[level]
+			}
+			return nil
+		})})
+	}
+
+	columns = append(columns,
+		Column{"total_ratings", Int, histogram(func(h *model.RatingHistogram) interface{} { return positive(h.TotalRatings) })},
+		Column{"average_star", Float, histogram(func(h *model.RatingHistogram) interface{} { return h.Average })},
+	)
+	for star := 5; star >= 1; star-- {
+		s := star
+		columns = append(columns, Column{fmt.Sprintf("star_%v_percent", s), Float, histogram(func(h *model.RatingHistogram) interface{} {
+			return h.Percent(s)
+		})})
+	}
+
+	columns = append(columns,
+		Column{"review_summary", String, p(func(p *model.ProductRecord) interface{} {
+			if p.ReviewInsights == nil {
+				return nil
+			}
+			return p.ReviewInsights.Summary
+		})},
+		Column{"error_count", Int, func(r *row) interface{} { return int64(len(r.result.Errors)) }},
+	)
+	return columns
+}
+
+func listingColumns() []Column {
+	i := func(f func(i *model.ListingItem) interface{}) func(r *row) interface{} {
+		return func(r *row) interface{} { return f(r.item) }
+	}
+
+	return []Column{
+		{"keyword", String, func(r *row) interface{} { return r.listing.Keyword }},
+		{"category", String, func(r *row) interface{} { return r.listing.Category }},
+		{"current_page", Int, func(r *row) interface{} { return integer(r.listing.CurrentPage) }},
+		{"rank", Int, i(func(i *model.ListingItem) interface{} { return positive(i.Rank) })},
+		{"position", Int, i(func(i *model.ListingItem) interface{} { return positive(i.Position) })},
+		{"organic_position", Int, i(func(i *model.ListingItem) interface{} { return positive(i.OrganicPosition) })},
+		{"sponsored_position", Int, i(func(i *model.ListingItem) interface{} { return positive(i.SponsoredPosition) })},
+		{"asin", String, i(func(i *model.ListingItem) interface{} { return i.ASIN })},
+		{"title", String, i(func(i *model.ListingItem) interface{} { return i.Title })},
+		{"price", String, i(func(i *model.ListingItem) interface{} { return i.Price })},
+		{"list_price_amount", Float, i(func(i *model.ListingItem) interface{} { return amount(i.ListPrice) })},
+		{"star", Float, i(func(i *model.ListingItem) interface{} { return number(i.Star) })},
+		{"rating", Int, i(func(i *model.ListingItem) interface{} { return integer(i.Rating) })},
+		{"img", String, i(func(i *model.ListingItem) interface{} { return i.Img })},
+		{"sponsored", Bool, i(func(i *model.ListingItem) interface{} { return i.Sponsored })},
+		{"prime", Bool, i(func(i *model.ListingItem) interface{} { return i.Prime })},
+		{"sales", String, i(func(i *model.ListingItem) interface{} { return i.Sales })},
+		{"deal_badge", String, i(func(i *model.ListingItem) interface{} { return i.DealBadge })},
+		{"coupon", String, i(func(i *model.ListingItem) interface{} {
+			if i.Coupon == nil {
+				return nil
+			}
+			return i.Coupon.Text
+		})},
+	}
+}
+
+func reviewColumns() []Column {
+	v := func(f func(v *model.Review) interface{}) func(r *row) interface{} {
+		return func(r *row) interface{} { return f(r.review) }
+	}
+
+	return []Column{
+		{"id", String, v(func(v *model.Review) interface{} { return v.ID })},
+		{"permalink", String, v(func(v *model.Review) interface{} { return v.Permalink })},
+		{"reviewer", String, v(func(v *model.Review) interface{} { return v.Reviewer })},
+		{"reviewer_link", String, v(func(v *model.Review) interface{} { return v.ReviewerLink })},
+		{"star", Float, v(func(v *model.Review) interface{} {
+			if v.Star == 0 {
+				return nil
+			}
+			return v.Star
+		})},
+		{"title", String, v(func(v *model.Review) interface{} { return v.Title })},
+		{"date", String, v(func(v *model.Review) interface{} { return v.Date })},
+		{"verified", Bool, v(func(v *model.Review) interface{} { return v.Verified })},
+		{"content", String, v(func(v *model.Review) interface{} { return v.Content })},
+		{"helpful_votes", Int, v(func(v *model.Review) interface{} { return int64(v.HelpfulVotes) })},
+		{"images", String, v(func(v *model.Review) interface{} { return strings.Join(v.Images, " ") })},
+		{"videos", String, v(func(v *model.Review) interface{} { return strings.Join(v.Videos, " ") })},
+		{"variant", String, v(func(v *model.Review) interface{} { return v.Variant })},
+		{"vine", Bool, v(func(v *model.Review) interface{} { return v.Vine })},
+		{"edited", Bool, v(func(v *model.Review) interface{} { return v.Edited })},
+	}
+}
+
+func priceInfo(p *model.ProductRecord) *model.PriceInfo {
+	if p.PriceInfo == nil {
+		return &model.PriceInfo{}
+	}
+	return p.PriceInfo
+}
+
+// histogram returns the value of a rating histogram column, nil if the
+// product has no histogram.
+func histogram(f func(h *model.RatingHistogram) interface{}) func(r *row) interface{} {
+	return func(r *row) interface{} {
+		if r.product.RatingHistogram == nil {
+			return nil
+		}
+		return f(r.product.RatingHistogram)
+	}
+}
+
+func amount(m *model.Money) interface{} {
+	if m == nil {
+		return nil
+	}
+	return m.Amount
+}
+
+func currency(m *model.Money) interface{} {
+	if m == nil {
+		return nil
+	}
+	return m.Currency
+}
+
+// positive returns n, nil if it is not set.
+func positive(n int) interface{} {
+	if n <= 0 {
+		return nil
+	}
+	return int64(n)
+}
+
+// number parses a decimal string field, nil if it is empty or malformed.
+func number(s string) interface{} {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return nil
+	}
+	return f
+}
+
+// integer parses an integer string field, nil if it is empty or malformed.
+func integer(s string) interface{} {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return n
+}
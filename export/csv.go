@@ -0,0 +1,92 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+)
+
+// CSVWriter writes results of one page type as CSV rows.
+type CSVWriter struct {
+	w       *csv.Writer
+	table   string
+	columns []Column
+	header  bool
+}
+
+// NewCSVWriter creates a CSV writer for results of the given page type.
+// The columns are selected by name, all columns are written if none is given.
+func NewCSVWriter(w io.Writer, pageType string, columns ...string) (*CSVWriter, error) {
+	t, err := table(pageType)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := selectColumns(pageType, columns)
+	if err != nil {
+		return nil, err
+	}
+	return &CSVWriter{w: csv.NewWriter(w), table: t, columns: selected}, nil
+}
+
+// Write writes the rows of the given result.
+func (w *CSVWriter) Write(result *goamzparser.Result) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	rows, err := rows(w.table, result)
+	if err != nil {
+		return err
+	}
+	for _, r := range rows {
+		record := make([]string, 0, len(w.columns))
+		for _, column := range w.columns {
+			record = append(record, format(column.valueOf(r)))
+		}
+		if err := w.w.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes any buffered data, including the header of an empty table.
+func (w *CSVWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *CSVWriter) writeHeader() error {
+	if w.header {
+		return nil
+	}
+	w.header = true
+
+	names := make([]string, 0, len(w.columns))
+	for _, column := range w.columns {
+		names = append(names, column.Name)
+	}
+	return w.w.Write(names)
+}
+
+// format formats a column value as a CSV field, missing values as an
+// empty field.
+func format(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,157 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+func productResult() *goamzparser.Result {
+	return &goamzparser.Result{
+		Region:   goamzparser.US,
+		PageType: goamzparser.PageProduct,
+		Record: &model.ProductRecord{
+			ASIN:              "B0ABCDEFGH",
+			Title:             "Cable, 2m",
+			Star:              "4.5",
+			Rating:            "1234",
+			CategoryHierarchy: []string{"Electronics", "Cables", "USB Cables"},
+			RatingHistogram:   &model.RatingHistogram{Percentages: [5]float64{5, 5, 10, 20, 60}, TotalRatings: 1234, Average: 4.5},
+		},
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf, goamzparser.PageProduct, "asin", "title", "rating", "category_path", "category_2", "category_4", "star_5_percent")
+	if err != nil {
+		t.Fatalf("Error creating writer: %s\n", err.Error())
+	}
+	if err := w.Write(productResult()); err != nil {
+		t.Fatalf("Error writing result: %s\n", err.Error())
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Error flushing writer: %s\n", err.Error())
+	}
+
+	want := "asin,title,rating,category_path,category_2,category_4,star_5_percent\n" +
+		"B0ABCDEFGH,\"Cable, 2m\",1234,Electronics > Cables > USB Cables,Cables,,60\n"
+	if buf.String() != want {
+		t.Errorf("Unexpected CSV:\n%v\nwant:\n%v\n", buf.String(), want)
+	}
+
+	if _, err := NewCSVWriter(&buf, goamzparser.PageProduct, "unknown"); err == nil {
+		t.Errorf("Expected an error for an unknown column\n")
+	}
+	if err := w.Write(&goamzparser.Result{PageType: goamzparser.PageReview, Record: &model.ReviewRecord{}}); err == nil {
+		t.Errorf("Expected an error for a result of another page type\n")
+	}
+}
+
+func TestParquetWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewParquetWriter(&buf, goamzparser.PageSearch)
+	if err != nil {
+		t.Fatalf("Error creating writer: %s\n", err.Error())
+	}
+
+	result := &goamzparser.Result{
+		Region:   goamzparser.US,
+		PageType: goamzparser.PageSearch,
+		Record: &model.ListingRecord{
+			Keyword: "usb cable",
+			Items: []*model.ListingItem{
+				{ASIN: "B0ABCDEFGH", Rank: 1, Star: "4.5"},
+				{ASIN: "B0ABCDEFGJ", Rank: 2, Sponsored: true},
+			},
+		},
+	}
+	if err := w.Write(result); err != nil {
+		t.Fatalf("Error writing result: %s\n", err.Error())
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Error closing writer: %s\n", err.Error())
+	}
+
+	type item struct {
+		Keyword   string  `parquet:"keyword"`
+		Rank      int64   `parquet:"rank"`
+		ASIN      string  `parquet:"asin"`
+		Star      float64 `parquet:"star"`
+		Sponsored bool    `parquet:"sponsored"`
+	}
+	items, err := parquet.Read[item](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Error reading parquet: %s\n", err.Error())
+	}
+	if len(items) != 2 || items[0] != (item{"usb cable", 1, "B0ABCDEFGH", 4.5, false}) ||
+		items[1] != (item{"usb cable", 2, "B0ABCDEFGJ", 0, true}) {
+		t.Errorf("Unexpected items: %+v\n", items)
+	}
+
+	file, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Error opening parquet: %s\n", err.Error())
+	}
+	if !strings.Contains(file.Schema().String(), "optional double star") {
+		t.Errorf("Unexpected schema: %v\n", file.Schema())
+	}
+}
+
+func TestMissingValues(t *testing.T) {
+	result := productResult()
+	result.Record.(*model.ProductRecord).PriceInfo = nil
+	result.Record.(*model.ProductRecord).RatingHistogram = nil
+	columns := []string{"asin", "price_amount", "currency", "savings_percent", "star", "total_ratings", "brand"}
+
+	var csvBuf bytes.Buffer
+	cw, err := NewCSVWriter(&csvBuf, goamzparser.PageProduct, columns...)
+	if err != nil {
+		t.Fatalf("Error creating writer: %s\n", err.Error())
+	}
+	if err := cw.Write(result); err != nil {
+		t.Fatalf("Error writing result: %s\n", err.Error())
+	}
+	if err := cw.Flush(); err != nil {
+		t.Fatalf("Error flushing writer: %s\n", err.Error())
+	}
+	if want := "asin,price_amount,currency,savings_percent,star,total_ratings,brand\nB0ABCDEFGH,,,,4.5,,\n"; csvBuf.String() != want {
+		t.Errorf("Unexpected CSV:\n%v\nwant:\n%v\n", csvBuf.String(), want)
+	}
+
+	var buf bytes.Buffer
+	pw, err := NewParquetWriter(&buf, goamzparser.PageProduct, columns...)
+	if err != nil {
+		t.Fatalf("Error creating writer: %s\n", err.Error())
+	}
+	if err := pw.Write(result); err != nil {
+		t.Fatalf("Error writing result: %s\n", err.Error())
+	}
+	if err := pw.Close(); err != nil {
+		t.Fatalf("Error closing writer: %s\n", err.Error())
+	}
+
+	type product struct {
+		ASIN           *string  `parquet:"asin,optional"`
+		PriceAmount    *float64 `parquet:"price_amount,optional"`
+		Currency       *string  `parquet:"currency,optional"`
+		SavingsPercent *int64   `parquet:"savings_percent,optional"`
+		Star           *float64 `parquet:"star,optional"`
+		TotalRatings   *int64   `parquet:"total_ratings,optional"`
+		Brand          *string  `parquet:"brand,optional"`
+	}
+	products, err := parquet.Read[product](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Error reading parquet: %s\n", err.Error())
+	}
+	if len(products) != 1 || products[0].ASIN == nil || *products[0].ASIN != "B0ABCDEFGH" || products[0].Star == nil || *products[0].Star != 4.5 ||
+		products[0].PriceAmount != nil || products[0].Currency != nil || products[0].SavingsPercent != nil ||
+		products[0].TotalRatings != nil || products[0].Brand != nil {
+		t.Errorf("Unexpected product: %+v\n", products[0])
+	}
+}
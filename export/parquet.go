@@ -0,0 +1,99 @@
+package export
+
+import (
+	"io"
+
+	"github.com/parquet-go/parquet-go"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+)
+
+// ParquetWriter writes results of one page type as an Apache Parquet file.
+type ParquetWriter struct {
+	w       *parquet.Writer
+	table   string
+	columns []Column
+	// index maps the schema columns to the selected columns.
+	index []int
+}
+
+// NewParquetWriter creates a Parquet writer for results of the given page
+// type. The columns are selected by name, all columns are written if none
+// is given. All columns are optional, missing values are written as NULL. Close must be called to write the file footer.
+func NewParquetWriter(w io.Writer, pageType string, columns ...string) (*ParquetWriter, error) {
+	t, err := table(pageType)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := selectColumns(pageType, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	group := make(parquet.Group, len(selected))
+	for _, column := range selected {
+		group[column.Name] = parquet.Optional(node(column.Type))
+	}
+	schema := parquet.NewSchema(t, group)
+
+	// the schema orders its columns by name.
+	byName := make(map[string]int, len(selected))
+	for i, column := range selected {
+		byName[column.Name] = i
+	}
+	index := make([]int, 0, len(selected))
+	for _, path := range schema.Columns() {
+		index = append(index, byName[path[0]])
+	}
+
+	return &ParquetWriter{
+		w:       parquet.NewWriter(w, schema),
+		table:   t,
+		columns: selected,
+		index:   index,
+	}, nil
+}
+
+// Write writes the rows of the given result.
+func (w *ParquetWriter) Write(result *goamzparser.Result) error {
+	rows, err := rows(w.table, result)
+	if err != nil {
+		return err
+	}
+
+	out := make([]parquet.Row, 0, len(rows))
+	for _, r := range rows {
+		values := make(parquet.Row, 0, len(w.index))
+		for i, c := range w.index {
+			// missing values are written as NULL at definition level 0.
+			v := w.columns[c].valueOf(r)
+			if v == nil {
+				values = append(values, parquet.NullValue().Level(0, 0, i))
+				continue
+			}
+			values = append(values, parquet.ValueOf(v).Level(0, 1, i))
+		}
+		out = append(out, values)
+	}
+	_, err = w.w.WriteRows(out)
+	return err
+}
+
+// Close flushes the rows and writes the file footer.
+func (w *ParquetWriter) Close() error {
+	return w.w.Close()
+}
+
+// node returns the Parquet node of a column type.
+func node(t Type) parquet.Node {
+	switch t {
+	case Int:
+		return parquet.Int(64)
+	case Float:
+		return parquet.Leaf(parquet.DoubleType)
+	case Bool:
+		return parquet.Leaf(parquet.BooleanType)
+	default:
+		return parquet.String()
+	}
+}
@@ -0,0 +1,436 @@
+package goamzparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+const (
+	PageProduct  = "product"
+	PageSearch   = "search"
+	PageCategory = "category"
+	PageSeller   = "seller"
+	PageBoard    = "board"
+	PageReview   = "review"
+)
+
+// PageTypes lists the supported page types.
+var PageTypes = []string{PageProduct, PageSearch, PageCategory, PageSeller, PageBoard, PageReview}
+
+// FieldError is the error of a single field of an extracted record.
+type FieldError struct {
+	Field string `json:"field"`
+	Error string `json:"error"`
+}
+
+// Result is the record extracted from a page together with the errors of
+// the fields that could not be parsed and of the values that failed
+// validation. The confidence of each field, and their mean, ranges from 0
+// for a missing or invalid value to 1 for a valid one.
+type Result struct {
+	SchemaVersion    string             `json:"schema_version"`
+	Region           string             `json:"region"`
+	PageType         string             `json:"page_type"`
+	Record           interface{}        `json:"record"`
+	Errors           []FieldError       `json:"errors,omitempty"`
+	ValidationErrors []FieldError       `json:"validation_errors,omitempty"`
+	Confidence       float64            `json:"confidence"`
+	FieldConfidence  map[string]float64 `json:"field_confidence"`
+
+	// fields are the names of the fields that were parsed, missing are the
+	// optional ones among them that were absent from the page.
+	fields  []string
+	missing map[string]bool
+}
+
+// DetectPageType guesses the page type of the given HTML document.
+func DetectPageType(doc *html.Node) (string, error) {
+	checks := []struct {
+		pageType string
+		expr     string
+	}{
+		{PageProduct, `//span[@id='productTitle'] | //div[@id='dp'] | //input[@id='ASIN']`},
+		{PageBoard, `//div[@data-client-recs-list] | //div[@id='gridItemRoot']`},
+		{PageReview, `//*[@data-hook='review'] | //div[@review]`},
+		{PageSeller, `//input[@name='me' and string-length(@value) > 0] | //a[contains(@href, '&me=') or contains(@href, '?me=')]`},
+		{PageSearch, `//input[@id='twotabsearchtextbox' and string-length(@value) > 0]`},
+		{PageCategory, `//div[@data-asin and @data-index and @data-uuid]`},
+	}
+
+	for _, check := range checks {
+		if _, err := utils.FindNodes(doc, check.expr, true); err == nil {
+			return check.pageType, nil
+		}
+	}
+	return "unknown", fmt.Errorf("'%v' error, unknown page type", "page")
+}
+
+// Extract parses the record of the given page type from the given HTML
+// document. An empty region or page type is detected from the document.
+// Fields that fail to parse are left empty and reported in Result.Errors,
+// implausible values are kept and reported in Result.ValidationErrors.
+func (p *Parser) Extract(doc *html.Node, region, pageType string) (*Result, error) {
+	var err error
+	if region == "" {
+		if region, err = ParseRegion(doc); err != nil {
+			return nil, err
+		}
+	}
+	region = strings.ToLower(region)
+	if pageType == "" {
+		if pageType, err = DetectPageType(doc); err != nil {
+			return nil, err
+		}
+	}
+
+	r := &Result{SchemaVersion: model.SchemaVersion, Region: region, PageType: pageType}
+	unsupported := fmt.Errorf("'%v' error, unsupported region for %v pages", region, pageType)
+
+	switch pageType {
+	case PageProduct:
+		parser := p.GetProductParser(region)
+		if parser == nil {
+			return nil, unsupported
+		}
+		r.Record = extractProduct(r, parser, doc)
+	case PageSearch:
+		parser := p.GetKeywordParser(region)
+		if parser == nil {
+			return nil, unsupported
+		}
+		r.Record = extractSearch(r, parser, doc)
+	case PageCategory:
+		parser := p.GetCategoryParser(region)
+		if parser == nil {
+			return nil, unsupported
+		}
+		r.Record = extractCategory(r, parser, doc, p.rankedProducts(region, doc))
+	case PageSeller:
+		parser := p.GetSellerParser(region)
+		if parser == nil {
+			return nil, unsupported
+		}
+		r.Record = extractSeller(r, parser, doc, p.rankedProducts(region, doc))
+	case PageBoard:
+		parser := p.GetBoardParser(region)
+		if parser == nil {
+			return nil, unsupported
+		}
+		r.Record = extractBoard(r, parser, doc)
+	case PageReview:
+		parser := p.GetReviewParser(region)
+		if parser == nil {
+			return nil, unsupported
+		}
+		r.Record = extractReviews(r, parser, doc)
+	default:
+		return nil, fmt.Errorf("'%v' error, unsupported page type", pageType)
+	}
+	r.validate()
+	return r, nil
+}
+
+// observe records the named field as parsed and err, if any, as its error.
+func (r *Result) observe(name string, err error) {
+	r.fields = append(r.fields, name)
+	if err != nil {
+		r.Errors = append(r.Errors, FieldError{Field: name, Error: err.Error()})
+	}
+}
+
+// observeOptional records the named optional field as parsed, and as
+// missing if err is not nil.
+func (r *Result) observeOptional(name string, err error) {
+	r.fields = append(r.fields, name)
+	if err != nil {
+		if r.missing == nil {
+			r.missing = make(map[string]bool)
+		}
+		r.missing[name] = true
+	}
+}
+
+// text returns the trimmed value of a string parser for the named field.
+// An error, an empty or an "unknown" value is recorded as a field error.
+func (r *Result) text(name string) func(string, error) string {
+	return func(v string, err error) string {
+		v = strings.TrimSpace(v)
+		if err == nil && (v == "" || v == "unknown") {
+			err = errors.ErrorEmptyValue
+		}
+		r.observe(name, err)
+		if err != nil {
+			return ""
+		}
+		return v
+	}
+}
+
+// marked reports whether a parser returning an error for a missing marker found it.
+func marked(_ string, err error) bool {
+	return err == nil
+}
+
+func extractProduct(r *Result, parser ProductParser, doc *html.Node) *model.ProductRecord {
+	record := &model.ProductRecord{}
+	var err error
+	record.ASIN = r.text("asin")(parser.ParseASIN(doc))
+	record.Title = r.text("title")(parser.ParseTitle(doc))
+	record.Brand = r.text("brand")(parser.ParseBrand(doc))
+	record.Price = r.text("price")(parser.ParsePrice(doc))
+	record.PrimePrice = r.optional("prime_price")(parser.ParsePrimePrice(doc))
+	record.Star = r.text("star")(parser.ParseStar(doc))
+	record.Rating = r.text("rating")(parser.ParseRating(doc))
+	record.Img = r.text("img")(parser.ParseImg(doc))
+	record.SoldBy = r.text("sold_by")(parser.ParseSoldBy(doc))
+	record.DispatchFrom = r.text("dispatch_from")(parser.ParseDispatchFrom(doc))
+	record.SellerID = r.text("seller_id")(parser.ParseSellerId(doc))
+	record.CategoryID = r.text("category_id")(parser.ParseCategoryId(doc))
+	record.HasCart = marked(parser.ParseHasCart(doc))
+	record.Coupon = r.optional("coupon")(parser.ParseCoupon(doc))
+	record.Color = r.optional("color")(parser.ParseColor(doc))
+	record.Size = r.optional("size")(parser.ParseSize(doc))
+	record.Description = r.text("description")(parser.ParseDescription(doc))
+	record.DeliveryTime = r.text("delivery_time")(parser.ParseDeliveryTime(doc))
+	record.FastestDelivery = r.optional("fastest_delivery")(parser.ParseFastestDelivery(doc))
+	record.ProductDimensions = r.text("product_dimensions")(parser.ParseProductDimensions(doc))
+	record.PackageDimensions = r.text("package_dimensions")(parser.ParsePackageDimensions(doc))
+	record.ProductWeight = r.text("product_weight")(parser.ParseProductWeight(doc))
+	record.PackageWeight = r.text("package_weight")(parser.ParsePackageWeight(doc))
+	record.FirstAvailDate = r.text("first_avail_date")(parser.ParseFirstAvailDate(doc))
+	record.Specs, err = parser.ParseSpecs(doc)
+	r.observe("specs", err)
+	record.CategoryHierarchy, err = parser.ParseCategoryHierarchy(doc)
+	r.observe("category_hierarchy", err)
+	record.CustomerReviews, err = parser.ParseCustomerReviews(doc)
+	r.observe("customer_reviews", err)
+	record.PriceInfo, err = parser.ParsePriceInfo(doc)
+	r.observe("price_info", err)
+	record.RatingHistogram, err = parser.ParseRatingHistogram(doc)
+	r.observe("rating_histogram", err)
+	record.ReviewInsights, err = parser.ParseReviewInsights(doc)
+	r.observeOptional("review_insights", err)
+	record.LocalReviews, err = parser.ParseLocalReviews(doc)
+	r.observeOptional("local_reviews", err)
+	record.ForeignReviews, err = parser.ParseForeignReviews(doc)
+	r.observeOptional("foreign_reviews", err)
+	return record
+}
+
+func extractSearch(r *Result, parser KeywordParser, doc *html.Node) *model.ListingRecord {
+	record := &model.ListingRecord{}
+	var err error
+	record.Keyword = r.text("keyword")(parser.ParseKeyword(doc))
+	record.CurrentPage = r.optional("current_page")(parser.ParseCurrentPageIndex(doc))
+	record.NextPageURL = r.optional("next_page_url")(parser.ParseNextPageURL(doc))
+	record.ResultCount, err = parser.ParseResultCount(doc)
+	r.observeOptional("result_count", err)
+	record.Refinements, err = parser.ParseRefinements(doc)
+	r.observeOptional("refinements", err)
+
+	nodes, err := parser.ParseAllProducts(doc)
+	r.observe("items", err)
+	record.Items = make([]*model.ListingItem, 0, len(nodes))
+	for i, node := range nodes {
+		name := itemField(i)
+		item := &model.ListingItem{}
+		item.ASIN = r.text(name("asin"))(parser.ParseASIN(node))
+		item.Title = r.text(name("title"))(parser.ParseTitle(node))
+		item.Price = r.text(name("price"))(parser.ParsePrice(node))
+		item.Star = r.text(name("star"))(parser.ParseStar(node))
+		item.Rating = r.text(name("rating"))(parser.ParseRating(node))
+		item.Img = r.text(name("img"))(parser.ParseImg(node))
+		item.Sponsored = marked(parser.ParseSponsered(node))
+		item.Prime = marked(parser.ParsePrime(node))
+		item.Sales = r.optional(name("sales"))(parser.ParseSales(node))
+		item.DealBadge = r.optional(name("deal_badge"))(parser.ParseDealBadge(node))
+		item.ListPrice, err = parser.ParseListPrice(node)
+		r.observeOptional(name("list_price"), err)
+		item.Coupon, err = parser.ParseCoupon(node)
+		r.observeOptional(name("coupon"), err)
+		record.Items = append(record.Items, item)
+	}
+	ranked, _ := parser.ParseRankedProducts(doc)
+	rankItems(record.Items, ranked)
+	return record
+}
+
+func extractCategory(r *Result, parser CategoryParser, doc *html.Node, ranked []*model.SearchResult) *model.ListingRecord {
+	record := &model.ListingRecord{}
+	var err error
+	record.Category = r.text("category")(parser.ParseCategoryName(doc))
+	record.CurrentPage = r.optional("current_page")(parser.ParseCurrentPageIndex(doc))
+	record.MaxPage = r.optional("max_page")(parser.ParseMaxPageNum(doc))
+	record.NextPageURL = r.optional("next_page_url")(parser.ParseNextPageURL(doc))
+	record.ResultCount, err = parser.ParseResultCount(doc)
+	r.observeOptional("result_count", err)
+	record.Refinements, err = parser.ParseRefinements(doc)
+	r.observeOptional("refinements", err)
+
+	nodes, err := parser.ParseAllProducts(doc)
+	r.observe("items", err)
+	record.Items = make([]*model.ListingItem, 0, len(nodes))
+	for i, node := range nodes {
+		name := itemField(i)
+		item := &model.ListingItem{}
+		item.ASIN = r.text(name("asin"))(parser.ParseASIN(node))
+		item.Title = r.text(name("title"))(parser.ParseTitle(node))
+		item.Price = r.text(name("price"))(parser.ParsePrice(node))
+		item.Star = r.text(name("star"))(parser.ParseStar(node))
+		item.Img = r.text(name("img"))(parser.ParseImg(node))
+		item.DealBadge = r.optional(name("deal_badge"))(parser.ParseDealBadge(node))
+		item.ListPrice, err = parser.ParseListPrice(node)
+		r.observeOptional(name("list_price"), err)
+		item.Coupon, err = parser.ParseCoupon(node)
+		r.observeOptional(name("coupon"), err)
+		record.Items = append(record.Items, item)
+	}
+	rankItems(record.Items, ranked)
+	return record
+}
+
+func extractSeller(r *Result, parser SellerParser, doc *html.Node, ranked []*model.SearchResult) *model.ListingRecord {
+	record := &model.ListingRecord{}
+	var err error
+	record.CurrentPage = r.optional("current_page")(parser.ParseCurrentPageIndex(doc))
+	record.MaxPage = r.optional("max_page")(parser.ParseMaxPageNum(doc))
+	record.NextPageURL = r.optional("next_page_url")(parser.ParseNextPageURL(doc))
+	record.ResultCount, err = parser.ParseResultCount(doc)
+	r.observeOptional("result_count", err)
+
+	nodes, err := parser.ParseAllProducts(doc)
+	r.observe("items", err)
+	record.Items = make([]*model.ListingItem, 0, len(nodes))
+	for i, node := range nodes {
+		name := itemField(i)
+		item := &model.ListingItem{}
+		item.ASIN = r.text(name("asin"))(parser.ParseASIN(node))
+		item.Title = r.text(name("title"))(parser.ParseTitle(node))
+		item.Price = r.text(name("price"))(parser.ParsePrice(node))
+		item.Star = r.text(name("star"))(parser.ParseStar(node))
+		item.Img = r.text(name("img"))(parser.ParseImg(node))
+		item.DealBadge = r.optional(name("deal_badge"))(parser.ParseDealBadge(node))
+		item.ListPrice, err = parser.ParseListPrice(node)
+		r.observeOptional(name("list_price"), err)
+		item.Coupon, err = parser.ParseCoupon(node)
+		r.observeOptional(name("coupon"), err)
+		record.Items = append(record.Items, item)
+	}
+	rankItems(record.Items, ranked)
+	return record
+}
+
+func extractBoard(r *Result, parser BoardParser, doc *html.Node) *model.ListingRecord {
+	record := &model.ListingRecord{}
+	record.NextPageURL = r.optional("next_page_url")(parser.ParseNextPageURL(doc))
+	category, err := parser.ParseBestSellersCategory(doc)
+	if err != nil {
+		category, err = parser.ParseNewReleasesCategory(doc)
+	}
+	record.Category = r.optional("category")(category, err)
+
+	nodes, err := parser.ParseAllProducts(doc)
+	r.observe("items", err)
+	record.Items = make([]*model.ListingItem, 0, len(nodes))
+	for i, node := range nodes {
+		name := itemField(i)
+		item := &model.ListingItem{}
+		item.ASIN = r.text(name("asin"))(parser.ParseASIN(node))
+		item.Title = r.text(name("title"))(parser.ParseTitle(node))
+		item.Price = r.text(name("price"))(parser.ParsePrice(node))
+		item.Star = r.text(name("star"))(parser.ParseStar(node))
+		item.Rating = r.text(name("rating"))(parser.ParseRating(node))
+		rank, err := parser.ParseRank(node)
+		r.observe(name("rank"), err)
+		if err == nil {
+			item.Rank, _ = strconv.Atoi(strings.TrimSpace(rank))
+		}
+		record.Items = append(record.Items, item)
+	}
+	return record
+}
+
+func extractReviews(r *Result, parser AmzReviewParser, doc *html.Node) *model.ReviewRecord {
+	record := &model.ReviewRecord{}
+	nodes, err := parser.ParseAllReviews(doc)
+	r.observe("reviews", err)
+	record.Reviews = make([]*model.Review, 0, len(nodes))
+	for i, node := range nodes {
+		review, err := parser.ParseReview(node)
+		r.observe(fmt.Sprintf("reviews[%v]", i), err)
+		if err != nil {
+			continue
+		}
+		record.Reviews = append(record.Reviews, review)
+	}
+	return record
+}
+
+// rankedProducts returns the ranked product cards of a page using the
+// search result layout, such as category and seller pages.
+func (p *Parser) rankedProducts(region string, doc *html.Node) []*model.SearchResult {
+	parser := p.GetKeywordParser(region)
+	if parser == nil {
+		return nil
+	}
+	ranked, _ := parser.ParseRankedProducts(doc)
+	return ranked
+}
+
+// rankItems sets the positions of the items from the ranked product cards
+// of the same ASIN, taken in page order. Organic items are ranked by their
+// organic position, sponsored items only carry their sponsored position.
+// Items without a ranked card only get their position in the list.
+func rankItems(items []*model.ListingItem, ranked []*model.SearchResult) {
+	cards := make(map[string][]*model.SearchResult)
+	for _, result := range ranked {
+		cards[result.ASIN] = append(cards[result.ASIN], result)
+	}
+
+	for i, item := range items {
+		queue := cards[item.ASIN]
+		if len(queue) == 0 {
+			item.Position = i + 1
+			continue
+		}
+		result := queue[0]
+		cards[item.ASIN] = queue[1:]
+
+		item.Position = result.Position
+		item.OrganicPosition = result.OrganicPosition
+		item.SponsoredPosition = result.SponsoredPosition
+		item.Sponsored = item.Sponsored || result.Sponsored
+		item.Rank = result.OrganicPosition
+	}
+}
+
+// itemField returns the field name builder of the i-th item.
+func itemField(i int) func(string) string {
+	return func(name string) string {
+		return fmt.Sprintf("items[%v].%v", i, name)
+	}
+}
+
+// optional returns the trimmed value of a string parser for the named
+// optional field. An error, an empty or an "unknown" value marks the field
+// as missing rather than failed.
+func (r *Result) optional(name string) func(string, error) string {
+	return func(v string, err error) string {
+		v = strings.TrimSpace(v)
+		if err == nil && (v == "" || v == "unknown") {
+			err = errors.ErrorEmptyValue
+		}
+		r.observeOptional(name, err)
+		if err != nil {
+			return ""
+		}
+		return v
+	}
+}
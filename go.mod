@@ -1,14 +1,26 @@
 module github.com/microsuite/go-amz-parser
 
-go 1.22.5
+go 1.22.7
 
 require (
 	github.com/antchfx/htmlquery v1.3.3
+	github.com/parquet-go/parquet-go v0.24.0
 	golang.org/x/net v0.30.0
+	google.golang.org/grpc v1.68.0
+	google.golang.org/protobuf v1.36.0
 )
 
 require (
+	github.com/andybalholm/brotli v1.1.0 // indirect
 	github.com/antchfx/xpath v1.3.2 // indirect
 	github.com/golang/groupcache v0.0.0-20210331224755-41bb18bfe9da // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/klauspost/compress v1.17.9 // indirect
+	github.com/mattn/go-runewidth v0.0.15 // indirect
+	github.com/olekukonko/tablewriter v0.0.5 // indirect
+	github.com/pierrec/lz4/v4 v4.1.21 // indirect
+	github.com/rivo/uniseg v0.4.7 // indirect
+	golang.org/x/sys v0.26.0 // indirect
 	golang.org/x/text v0.19.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240903143218-8af14fe29dc1 // indirect
 )
@@ -0,0 +1,286 @@
+package grpcserver
+
+import (
+	goamzparser "github.com/microsuite/go-amz-parser"
+	amzparserv1 "github.com/microsuite/go-amz-parser/api/amzparser/v1"
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+func toProductResult(result *goamzparser.Result) *amzparserv1.ProductResult {
+	record, ok := result.Record.(*model.ProductRecord)
+	if !ok {
+		record = &model.ProductRecord{}
+	}
+
+	return &amzparserv1.ProductResult{
+		Region:            result.Region,
+		Errors:            toFieldErrors(result.Errors),
+		ValidationErrors:  toFieldErrors(result.ValidationErrors),
+		Confidence:        result.Confidence,
+		FieldConfidence:   result.FieldConfidence,
+		Asin:              record.ASIN,
+		Title:             record.Title,
+		Brand:             record.Brand,
+		Price:             record.Price,
+		PrimePrice:        record.PrimePrice,
+		Star:              record.Star,
+		Rating:            record.Rating,
+		Img:               record.Img,
+		SoldBy:            record.SoldBy,
+		DispatchFrom:      record.DispatchFrom,
+		SellerId:          record.SellerID,
+		CategoryId:        record.CategoryID,
+		HasCart:           record.HasCart,
+		Coupon:            record.Coupon,
+		Color:             record.Color,
+		Size:              record.Size,
+		Description:       record.Description,
+		DeliveryTime:      record.DeliveryTime,
+		FastestDelivery:   record.FastestDelivery,
+		ProductDimensions: record.ProductDimensions,
+		PackageDimensions: record.PackageDimensions,
+		ProductWeight:     record.ProductWeight,
+		PackageWeight:     record.PackageWeight,
+		FirstAvailDate:    record.FirstAvailDate,
+		Specs:             record.Specs,
+		CategoryHierarchy: record.CategoryHierarchy,
+		CustomerReviews:   record.CustomerReviews,
+		PriceInfo:         toPriceInfo(record.PriceInfo),
+		RatingHistogram:   toRatingHistogram(record.RatingHistogram),
+		ReviewInsights:    toReviewInsights(record.ReviewInsights),
+		LocalReviews:      toReviews(record.LocalReviews),
+		ForeignReviews:    toReviews(record.ForeignReviews),
+	}
+}
+
+func toSearchResult(result *goamzparser.Result) *amzparserv1.SearchResult {
+	record := listing(result)
+	return &amzparserv1.SearchResult{
+		Region:           result.Region,
+		Errors:           toFieldErrors(result.Errors),
+		ValidationErrors: toFieldErrors(result.ValidationErrors),
+		Confidence:       result.Confidence,
+		FieldConfidence:  result.FieldConfidence,
+		Keyword:          record.Keyword,
+		CurrentPage:      record.CurrentPage,
+		NextPageUrl:      record.NextPageURL,
+		ResultCount:      toResultCount(record.ResultCount),
+		Refinements:      toRefinementGroups(record.Refinements),
+		Items:            toListingItems(record.Items),
+	}
+}
+
+func toCategoryResult(result *goamzparser.Result) *amzparserv1.CategoryResult {
+	record := listing(result)
+	return &amzparserv1.CategoryResult{
+		Region:           result.Region,
+		Errors:           toFieldErrors(result.Errors),
+		ValidationErrors: toFieldErrors(result.ValidationErrors),
+		Confidence:       result.Confidence,
+		FieldConfidence:  result.FieldConfidence,
+		Category:         record.Category,
+		CurrentPage:      record.CurrentPage,
+		MaxPage:          record.MaxPage,
+		NextPageUrl:      record.NextPageURL,
+		ResultCount:      toResultCount(record.ResultCount),
+		Refinements:      toRefinementGroups(record.Refinements),
+		Items:            toListingItems(record.Items),
+	}
+}
+
+func toSellerResult(result *goamzparser.Result) *amzparserv1.SellerResult {
+	record := listing(result)
+	return &amzparserv1.SellerResult{
+		Region:           result.Region,
+		Errors:           toFieldErrors(result.Errors),
+		ValidationErrors: toFieldErrors(result.ValidationErrors),
+		Confidence:       result.Confidence,
+		FieldConfidence:  result.FieldConfidence,
+		CurrentPage:      record.CurrentPage,
+		MaxPage:          record.MaxPage,
+		NextPageUrl:      record.NextPageURL,
+		ResultCount:      toResultCount(record.ResultCount),
+		Items:            toListingItems(record.Items),
+	}
+}
+
+func toBoardResult(result *goamzparser.Result) *amzparserv1.BoardResult {
+	record := listing(result)
+	return &amzparserv1.BoardResult{
+		Region:           result.Region,
+		Errors:           toFieldErrors(result.Errors),
+		ValidationErrors: toFieldErrors(result.ValidationErrors),
+		Confidence:       result.Confidence,
+		FieldConfidence:  result.FieldConfidence,
+		Category:         record.Category,
+		NextPageUrl:      record.NextPageURL,
+		Items:            toListingItems(record.Items),
+	}
+}
+
+func toReviewResult(result *goamzparser.Result) *amzparserv1.ReviewResult {
+	record, ok := result.Record.(*model.ReviewRecord)
+	if !ok {
+		record = &model.ReviewRecord{}
+	}
+	return &amzparserv1.ReviewResult{
+		Region:           result.Region,
+		Errors:           toFieldErrors(result.Errors),
+		ValidationErrors: toFieldErrors(result.ValidationErrors),
+		Confidence:       result.Confidence,
+		FieldConfidence:  result.FieldConfidence,
+		Reviews:          toReviews(record.Reviews),
+	}
+}
+
+func toFieldErrors(errs []goamzparser.FieldError) []*amzparserv1.FieldError {
+	out := make([]*amzparserv1.FieldError, 0, len(errs))
+	for _, e := range errs {
+		out = append(out, &amzparserv1.FieldError{Field: e.Field, Error: e.Error})
+	}
+	return out
+}
+
+func toMoney(m *model.Money) *amzparserv1.Money {
+	if m == nil {
+		return nil
+	}
+	return &amzparserv1.Money{Amount: m.Amount, Currency: m.Currency}
+}
+
+func toCoupon(c *model.Coupon) *amzparserv1.Coupon {
+	if c == nil {
+		return nil
+	}
+	return &amzparserv1.Coupon{Percent: c.Percent, Amount: toMoney(c.Amount), Text: c.Text}
+}
+
+func toPriceInfo(info *model.PriceInfo) *amzparserv1.PriceInfo {
+	if info == nil {
+		return nil
+	}
+
+	out := &amzparserv1.PriceInfo{
+		Price:              toMoney(info.Price),
+		ListPrice:          toMoney(info.ListPrice),
+		SavingsPercent:     int32(info.SavingsPercent),
+		DealBadge:          info.DealBadge,
+		SubscribeSavePrice: toMoney(info.SubscribeSavePrice),
+		Coupon:             toCoupon(info.Coupon),
+	}
+	if deal := info.LightningDeal; deal != nil {
+		out.LightningDeal = &amzparserv1.LightningDeal{ClaimedPercent: int32(deal.ClaimedPercent), EndsIn: deal.EndsIn}
+	}
+	return out
+}
+
+func toRatingHistogram(h *model.RatingHistogram) *amzparserv1.RatingHistogram {
+	if h == nil {
+		return nil
+	}
+	return &amzparserv1.RatingHistogram{
+		Percentages:  h.Percentages[:],
+		TotalRatings: int32(h.TotalRatings),
+		Average:      h.Average,
+	}
+}
+
+func toReviewInsights(insights *model.ReviewInsights) *amzparserv1.ReviewInsights {
+	if insights == nil {
+		return nil
+	}
+
+	out := &amzparserv1.ReviewInsights{Summary: insights.Summary}
+	for _, aspect := range insights.Aspects {
+		out.Aspects = append(out.Aspects, &amzparserv1.ReviewAspect{
+			Name:      aspect.Name,
+			Sentiment: aspect.Sentiment,
+			Mentions:  int32(aspect.Mentions),
+			Positive:  int32(aspect.Positive),
+			Negative:  int32(aspect.Negative),
+		})
+	}
+	return out
+}
+
+func toReviews(reviews []*model.Review) []*amzparserv1.Review {
+	out := make([]*amzparserv1.Review, 0, len(reviews))
+	for _, review := range reviews {
+		out = append(out, &amzparserv1.Review{
+			Id:           review.ID,
+			Permalink:    review.Permalink,
+			Reviewer:     review.Reviewer,
+			ReviewerLink: review.ReviewerLink,
+			Star:         review.Star,
+			Title:        review.Title,
+			Date:         review.Date,
+			Verified:     review.Verified,
+			Content:      review.Content,
+			HelpfulVotes: int32(review.HelpfulVotes),
+			Images:       review.Images,
+			Videos:       review.Videos,
+			Variant:      review.Variant,
+			Vine:         review.Vine,
+			Edited:       review.Edited,
+		})
+	}
+	return out
+}
+
+func toResultCount(count *model.ResultCount) *amzparserv1.ResultCount {
+	if count == nil {
+		return nil
+	}
+	return &amzparserv1.ResultCount{
+		Start: int32(count.Start),
+		End:   int32(count.End),
+		Total: int32(count.Total),
+		Over:  count.Over,
+		Query: count.Query,
+	}
+}
+
+func toRefinementGroups(groups []*model.RefinementGroup) []*amzparserv1.RefinementGroup {
+	out := make([]*amzparserv1.RefinementGroup, 0, len(groups))
+	for _, group := range groups {
+		g := &amzparserv1.RefinementGroup{Id: group.ID, Name: group.Name}
+		for _, option := range group.Options {
+			g.Options = append(g.Options, &amzparserv1.RefinementOption{
+				Id:       option.ID,
+				Label:    option.Label,
+				Count:    int32(option.Count),
+				Selected: option.Selected,
+				Url:      option.URL,
+				Filter:   option.Filter,
+			})
+		}
+		out = append(out, g)
+	}
+	return out
+}
+
+func toListingItems(items []*model.ListingItem) []*amzparserv1.ListingItem {
+	out := make([]*amzparserv1.ListingItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, &amzparserv1.ListingItem{
+			Asin:      item.ASIN,
+			Title:     item.Title,
+			Price:     item.Price,
+			ListPrice: toMoney(item.ListPrice),
+			Star:      item.Star,
+			Rating:    item.Rating,
+			Img:       item.Img,
+			Rank:      int32(item.Rank),
+			Sponsored: item.Sponsored,
+			Prime:     item.Prime,
+			Sales:     item.Sales,
+			DealBadge: item.DealBadge,
+			Coupon:    toCoupon(item.Coupon),
+
+			Position:          int32(item.Position),
+			OrganicPosition:   int32(item.OrganicPosition),
+			SponsoredPosition: int32(item.SponsoredPosition),
+		})
+	}
+	return out
+}
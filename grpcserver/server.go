@@ -0,0 +1,180 @@
+// Package grpcserver serves the parsers of goamzparser.Parser over gRPC.
+package grpcserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+
+	"github.com/antchfx/htmlquery"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+	amzparserv1 "github.com/microsuite/go-amz-parser/api/amzparser/v1"
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+// DefaultMaxSize is the default maximum size in bytes of the decompressed
+// HTML of a request.
+const DefaultMaxSize = 32 << 20
+
+// Server implements amzparserv1.AmzParserServer.
+type Server struct {
+	amzparserv1.UnimplementedAmzParserServer
+
+	// MaxSize is the maximum size in bytes of the decompressed HTML of a
+	// request, DefaultMaxSize if zero. Larger pages are rejected with
+	// codes.ResourceExhausted.
+	MaxSize int64
+
+	parser *goamzparser.Parser
+}
+
+// NewServer creates a server parsing the pages with the given parser.
+func NewServer(parser *goamzparser.Parser) *Server {
+	return &Server{parser: parser}
+}
+
+// ParseProduct parses a product detail page.
+func (s *Server) ParseProduct(ctx context.Context, req *amzparserv1.ParseRequest) (*amzparserv1.ProductResult, error) {
+	result, err := s.extract(req, goamzparser.PageProduct)
+	if err != nil {
+		return nil, err
+	}
+	return toProductResult(result), nil
+}
+
+// ParseSearch parses a keyword search page.
+func (s *Server) ParseSearch(ctx context.Context, req *amzparserv1.ParseRequest) (*amzparserv1.SearchResult, error) {
+	result, err := s.extract(req, goamzparser.PageSearch)
+	if err != nil {
+		return nil, err
+	}
+	return toSearchResult(result), nil
+}
+
+// ParseCategory parses a category page.
+func (s *Server) ParseCategory(ctx context.Context, req *amzparserv1.ParseRequest) (*amzparserv1.CategoryResult, error) {
+	result, err := s.extract(req, goamzparser.PageCategory)
+	if err != nil {
+		return nil, err
+	}
+	return toCategoryResult(result), nil
+}
+
+// ParseSeller parses a seller storefront page.
+func (s *Server) ParseSeller(ctx context.Context, req *amzparserv1.ParseRequest) (*amzparserv1.SellerResult, error) {
+	result, err := s.extract(req, goamzparser.PageSeller)
+	if err != nil {
+		return nil, err
+	}
+	return toSellerResult(result), nil
+}
+
+// ParseBoard parses a best sellers or new releases page.
+func (s *Server) ParseBoard(ctx context.Context, req *amzparserv1.ParseRequest) (*amzparserv1.BoardResult, error) {
+	result, err := s.extract(req, goamzparser.PageBoard)
+	if err != nil {
+		return nil, err
+	}
+	return toBoardResult(result), nil
+}
+
+// ParseReviews parses a customer reviews page.
+func (s *Server) ParseReviews(ctx context.Context, req *amzparserv1.ParseRequest) (*amzparserv1.ReviewResult, error) {
+	result, err := s.extract(req, goamzparser.PageReview)
+	if err != nil {
+		return nil, err
+	}
+	return toReviewResult(result), nil
+}
+
+// ParseBatch parses a stream of pages. A page that cannot be parsed is
+// answered with an error message instead of ending the stream.
+func (s *Server) ParseBatch(stream amzparserv1.AmzParser_ParseBatchServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp := &amzparserv1.BatchResponse{Id: req.GetId()}
+		result, err := s.extract(req.GetRequest(), req.GetPageType())
+		if err != nil {
+			resp.Error = status.Convert(err).Message()
+		} else {
+			setBatchResult(resp, result)
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// extract decodes the HTML of the request and extracts the record of the
+// given page type, detected from the document when empty.
+func (s *Server) extract(req *amzparserv1.ParseRequest, pageType string) (*goamzparser.Result, error) {
+	var body io.Reader = bytes.NewReader(req.GetHtml())
+	if data := req.GetHtml(); len(data) > 1 && data[0] == 0x1f && data[1] == 0x8b {
+		zr, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		defer zr.Close()
+		body = zr
+	}
+
+	maxSize := s.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	data, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if int64(len(data)) > maxSize {
+		return nil, status.Errorf(codes.ResourceExhausted, "html exceeds %v bytes", maxSize)
+	}
+
+	doc, err := htmlquery.Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.parser.Extract(doc, req.GetRegion(), pageType)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return result, nil
+}
+
+// setBatchResult sets the result of the page type of the given result.
+func setBatchResult(resp *amzparserv1.BatchResponse, result *goamzparser.Result) {
+	switch result.PageType {
+	case goamzparser.PageProduct:
+		resp.Result = &amzparserv1.BatchResponse_Product{Product: toProductResult(result)}
+	case goamzparser.PageSearch:
+		resp.Result = &amzparserv1.BatchResponse_Search{Search: toSearchResult(result)}
+	case goamzparser.PageCategory:
+		resp.Result = &amzparserv1.BatchResponse_Category{Category: toCategoryResult(result)}
+	case goamzparser.PageSeller:
+		resp.Result = &amzparserv1.BatchResponse_Seller{Seller: toSellerResult(result)}
+	case goamzparser.PageBoard:
+		resp.Result = &amzparserv1.BatchResponse_Board{Board: toBoardResult(result)}
+	case goamzparser.PageReview:
+		resp.Result = &amzparserv1.BatchResponse_Review{Review: toReviewResult(result)}
+	}
+}
+
+// listing returns the listing record of a result.
+func listing(result *goamzparser.Result) *model.ListingRecord {
+	if record, ok := result.Record.(*model.ListingRecord); ok {
+		return record
+	}
+	return &model.ListingRecord{}
+}
@@ -0,0 +1,144 @@
+package grpcserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+	amzparserv1 "github.com/microsuite/go-amz-parser/api/amzparser/v1"
+)
+
+const searchPage = `<html lang="en-us"><body>
+	<input id="twotabsearchtextbox" value="usb cable"/>
+	<div class="s-result-item" data-asin="B0ABCDEFGH" data-index="1" data-uuid="a">
+		<div><h2 class="a-text-normal"><span>Cable A</span></h2></div>
+	</div>
+</body></html>`
+
+const reviewPage = `<html lang="en-us"><body>
+	<li id="R1ABC2DEF3GHI4" data-hook="review">
+		<div id="customer_review-R1ABC2DEF3GHI4"><span data-hook="review-title"><span>Great</span></span></div>
+	</li>
+</body></html>`
+
+func newClient(t *testing.T) amzparserv1.AmzParserClient {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	amzparserv1.RegisterAmzParserServer(srv, NewServer(goamzparser.NewParser()))
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Error dialing server: %s\n", err.Error())
+	}
+	t.Cleanup(func() { conn.Close() })
+	return amzparserv1.NewAmzParserClient(conn)
+}
+
+func TestParseSearch(t *testing.T) {
+	client := newClient(t)
+
+	result, err := client.ParseSearch(context.Background(), &amzparserv1.ParseRequest{Html: []byte(searchPage)})
+	if err != nil {
+		t.Fatalf("Error parsing search page: %s\n", err.Error())
+	}
+	if result.Region != "en-us" || result.Keyword != "usb cable" || len(result.Items) != 1 ||
+		result.Items[0].Asin != "B0ABCDEFGH" || len(result.Errors) == 0 {
+		t.Errorf("Unexpected result: %v\n", result)
+	}
+	if result.Confidence <= 0 || result.Confidence >= 1 || result.FieldConfidence["items[0].asin"] != 1 ||
+		result.FieldConfidence["items[0].price"] != 0 || len(result.ValidationErrors) != 0 {
+		t.Errorf("Unexpected confidence: %v %v %v\n", result.Confidence, result.FieldConfidence, result.ValidationErrors)
+	}
+
+	invalid := strings.ReplaceAll(searchPage, "B0ABCDEFGH", "B0ABC")
+	result, err = client.ParseSearch(context.Background(), &amzparserv1.ParseRequest{Html: []byte(invalid)})
+	if err != nil {
+		t.Fatalf("Error parsing search page: %s\n", err.Error())
+	}
+	if len(result.ValidationErrors) != 1 || result.ValidationErrors[0].Field != "items[0].asin" || result.FieldConfidence["items[0].asin"] != 0 {
+		t.Errorf("Unexpected validation errors: %v\n", result.ValidationErrors)
+	}
+
+	if _, err := client.ParseSearch(context.Background(), &amzparserv1.ParseRequest{Html: []byte(searchPage), Region: "ja-jp"}); err == nil {
+		t.Errorf("Expected an error for an unsupported region\n")
+	}
+}
+
+func TestParseBatch(t *testing.T) {
+	client := newClient(t)
+
+	stream, err := client.ParseBatch(context.Background())
+	if err != nil {
+		t.Fatalf("Error opening stream: %s\n", err.Error())
+	}
+
+	requests := []*amzparserv1.BatchRequest{
+		{Id: "search", PageType: "search", Request: &amzparserv1.ParseRequest{Html: []byte(searchPage)}},
+		{Id: "review", Request: &amzparserv1.ParseRequest{Html: []byte(reviewPage)}},
+		{Id: "bad", PageType: "unknown", Request: &amzparserv1.ParseRequest{Html: []byte(reviewPage)}},
+	}
+	for _, req := range requests {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("Error sending request: %s\n", err.Error())
+		}
+	}
+	stream.CloseSend()
+
+	responses := make(map[string]*amzparserv1.BatchResponse)
+	for range requests {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Error receiving response: %s\n", err.Error())
+		}
+		responses[resp.Id] = resp
+	}
+
+	if search := responses["search"].GetSearch(); search == nil || search.Keyword != "usb cable" {
+		t.Errorf("Unexpected search response: %v\n", responses["search"])
+	}
+	if review := responses["review"].GetReview(); review == nil || len(review.Reviews) != 1 || review.Reviews[0].Id != "R1ABC2DEF3GHI4" {
+		t.Errorf("Unexpected review response: %v\n", responses["review"])
+	}
+	if responses["bad"].Error == "" {
+		t.Errorf("Expected an error response: %v\n", responses["bad"])
+	}
+}
+
+func TestParseMaxSize(t *testing.T) {
+	s := NewServer(goamzparser.NewParser())
+	s.MaxSize = 1 << 10
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(searchPage + strings.Repeat(" ", 1<<20)))
+	zw.Close()
+
+	_, err := s.ParseSearch(context.Background(), &amzparserv1.ParseRequest{Html: buf.Bytes()})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Unexpected error: %v\n", err)
+	}
+
+	buf.Reset()
+	zw = gzip.NewWriter(&buf)
+	zw.Write([]byte(searchPage))
+	zw.Close()
+	if _, err := s.ParseSearch(context.Background(), &amzparserv1.ParseRequest{Html: buf.Bytes()}); err != nil {
+		t.Errorf("Error parsing gzip page: %s\n", err.Error())
+	}
+}
@@ -0,0 +1,48 @@
+package board
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+// rec is the JSON layout of a data-client-recs-list entry.
+type rec struct {
+	ID          string                     `json:"id"`
+	MetadataMap map[string]json.RawMessage `json:"metadataMap"`
+}
+
+// parseRecs decodes the data-client-recs-list attribute. The rank is taken
+// from the "render.zg.rank" metadata, falling back to the list position.
+func parseRecs(recsList string, offset string) ([]*model.BoardRec, error) {
+	var entries []rec
+	if err := json.Unmarshal([]byte(recsList), &entries); err != nil {
+		return nil, fmt.Errorf("'%v' error, %v", "data-client-recs-list", err)
+	}
+
+	start, _ := strconv.Atoi(offset)
+	recs := make([]*model.BoardRec, 0, len(entries))
+	for i, entry := range entries {
+		item := &model.BoardRec{
+			ASIN:     entry.ID,
+			Rank:     start + i + 1,
+			Metadata: make(map[string]string, len(entry.MetadataMap)),
+		}
+
+		for key, value := range entry.MetadataMap {
+			var s string
+			if err := json.Unmarshal(value, &s); err != nil {
+				s = strings.TrimSpace(string(value))
+			}
+			item.Metadata[key] = s
+		}
+		if rank, err := strconv.Atoi(item.Metadata["render.zg.rank"]); err == nil {
+			item.Rank = rank
+		}
+		recs = append(recs, item)
+	}
+	return recs, nil
+}
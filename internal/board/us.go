@@ -5,6 +5,7 @@ import (
 
 	"github.com/antchfx/htmlquery"
 	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
 	"github.com/microsuite/go-amz-parser/utils"
 	"golang.org/x/net/html"
 )
@@ -190,3 +191,17 @@ func (p *USBoardParser) ParseRank(node *html.Node) (string, error) {
 	}
 	return "unknown", errors.ErrorNotFoundRank
 }
+
+// ParseRecsItems parses the ranked ASINs of the recs list from the given html document.
+func (p *USBoardParser) ParseRecsItems(doc *html.Node) ([]*model.BoardRec, error) {
+	recsList, err := p.ParseRecsList(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := p.ParseOffset(doc)
+	if err != nil {
+		offset = "0"
+	}
+	return parseRecs(recsList, offset)
+}
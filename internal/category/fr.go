@@ -7,6 +7,8 @@ import (
 
 	"github.com/antchfx/htmlquery"
 	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/internal/refinement"
+	"github.com/microsuite/go-amz-parser/model"
 	"github.com/microsuite/go-amz-parser/utils"
 )
 
@@ -182,3 +184,72 @@ func (p *FRCategoryParser) ParseTitle(node *html.Node) (string, error) {
 	}
 	return utils.FormatTitle(nodes[0].Data), nil
 }
+
+// ParseCoupon parses the coupon from the given HTML node.
+func (p *FRCategoryParser) ParseCoupon(node *html.Node) (*model.Coupon, error) {
+	exprs := []string{
+		`//span[contains(@class, 's-coupon-unclipped')]`,
+		`//span[contains(text(), 'avec coupon')]/..`,
+	}
+
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(node, expr, true)
+		if err == nil {
+			if coupon := utils.ParseCoupon(htmlquery.InnerText(nodes[0])); coupon != nil {
+				return coupon, nil
+			}
+		}
+	}
+	return nil, errors.ErrorNotFoundCoupon
+}
+
+// ParseDealBadge parses the deal badge from the given HTML node.
+func (p *FRCategoryParser) ParseDealBadge(node *html.Node) (string, error) {
+	exprs := []string{
+		`//span[@data-a-badge-color='sx-lightning-deal-red']/text()`,
+		`//span[contains(text(), 'Offre à durée limitée')]/text()`,
+	}
+
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(node, expr, true)
+		if err == nil && strings.TrimSpace(nodes[0].Data) != "" {
+			return strings.TrimSpace(nodes[0].Data), nil
+		}
+	}
+	return "unknown", errors.ErrorNotFoundDeal
+}
+
+// ParseListPrice parses the strike-through list price from the given HTML node.
+func (p *FRCategoryParser) ParseListPrice(node *html.Node) (*model.Money, error) {
+	expr := `//span[contains(@class, 'a-text-price') and @data-a-strike='true']/span[@class='a-offscreen']/text()`
+	nodes, err := utils.FindNodes(node, expr, true)
+	if err != nil {
+		return nil, err
+	}
+	return utils.ParseMoney(nodes[0].Data)
+}
+
+// ParseRefinements parses the refinement groups of the left navigation from the given HTML document.
+func (p *FRCategoryParser) ParseRefinements(doc *html.Node) ([]*model.RefinementGroup, error) {
+	return refinement.Parse(doc)
+}
+
+// ParseResultCount parses the result range, total count and query from the given HTML document.
+func (p *FRCategoryParser) ParseResultCount(doc *html.Node) (*model.ResultCount, error) {
+	expr := `//span[contains(text(), 'résultats')]`
+	nodes, err := utils.FindNodes(doc, expr, true)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := utils.ParseResultCount(htmlquery.InnerText(nodes[0]), "plus de")
+	if err != nil {
+		return nil, err
+	}
+
+	queryExpr := `//span[contains(text(), 'résultats')]/following-sibling::span[1]/text()`
+	if queries, err := utils.FindNodes(doc, queryExpr, true); err == nil {
+		count.Query = strings.Trim(strings.TrimSpace(queries[0].Data), `"“”„«» `)
+	}
+	return count, nil
+}
@@ -0,0 +1,227 @@
+package keyword
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+// placementLabels holds the localized texts used to classify search slots.
+type placementLabels struct {
+	sponsored     string
+	amazonsChoice string
+	bestSeller    string
+	highlyRated   string
+}
+
+var dpRegex = regexp.MustCompile(`/dp/([A-Z0-9]{10})`)
+
+// parsePlacements classifies every top level slot of a search result page
+// and returns one placement per ASIN in page order.
+func parsePlacements(doc *html.Node, labels placementLabels) ([]*model.Placement, error) {
+	expr := `//div[@data-index and contains(@class, 's-result-item') and not(ancestor::div[@data-index and contains(@class, 's-result-item')])]`
+	slots, err := utils.FindNodes(doc, expr, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var organic, sponsored, position int
+	placements := make([]*model.Placement, 0, len(slots))
+	for _, slot := range slots {
+		typ := classifySlot(slot, labels)
+		for _, asin := range slotASINs(slot) {
+			position++
+			card := slot
+			if nodes, err := utils.FindNodes(slot, fmt.Sprintf(`//*[@data-asin='%v']`, asin), true); err == nil {
+				card = nodes[0]
+			}
+
+			placement := &model.Placement{
+				ASIN:     asin,
+				Type:     typ,
+				Badges:   parseBadges(card, labels),
+				Position: position,
+			}
+
+			switch {
+			case typ == model.PlacementOrganic:
+				organic++
+				placement.OrganicPosition = organic
+			case placement.IsSponsored():
+				sponsored++
+				placement.SponsoredPosition = sponsored
+			}
+			placements = append(placements, placement)
+		}
+	}
+	return placements, nil
+}
+
+// rankProducts assigns positions across the result set to the product
+// cards returned by ParseAllProducts, keeping their order. The positions of
+// a page continue those of the previous pages, assuming they showed as many
+// cards of each group. Organic positions start after the first result of
+// the result count instead, when it is known.
+func rankProducts(nodes []*html.Node, page int, count *model.ResultCount, labels placementLabels) []*model.SearchResult {
+	results := make([]*model.SearchResult, 0, len(nodes))
+	var organicCards, sponsoredCards int
+	for _, node := range nodes {
+		result := &model.SearchResult{
+			ASIN:      htmlquery.SelectAttr(node, "data-asin"),
+			Page:      page,
+			Sponsored: isSponsoredSlot(node, labels),
+		}
+		result.Index, _ = strconv.Atoi(htmlquery.SelectAttr(node, "data-index"))
+		if result.Sponsored {
+			sponsoredCards++
+		} else {
+			organicCards++
+		}
+		results = append(results, result)
+	}
+
+	position := (page - 1) * len(nodes)
+	organic := (page - 1) * organicCards
+	sponsored := (page - 1) * sponsoredCards
+	if count != nil && count.Start > 0 {
+		organic = count.Start - 1
+	}
+	for _, result := range results {
+		position++
+		result.Position = position
+		if result.Sponsored {
+			sponsored++
+			result.SponsoredPosition = sponsored
+		} else {
+			organic++
+			result.OrganicPosition = organic
+		}
+	}
+	return results
+}
+
+// classifySlot returns the placement type of a search result slot.
+func classifySlot(slot *html.Node, labels placementLabels) model.PlacementType {
+	widgets := strings.ToUpper(slotWidgets(slot))
+
+	switch {
+	case strings.Contains(widgets, "VIDEO") && isSponsoredSlot(slot, labels):
+		return model.PlacementSponsoredVideo
+	case strings.Contains(widgets, "SB-") || strings.Contains(widgets, "SB_") ||
+		strings.Contains(widgets, "-SB") || strings.Contains(widgets, "SPONSORED_BRAND"):
+		return model.PlacementSponsoredBrand
+	case strings.Contains(widgets, "EDITORIAL"):
+		return model.PlacementEditorial
+	case isSponsoredSlot(slot, labels):
+		return model.PlacementSponsoredProduct
+	default:
+		return model.PlacementOrganic
+	}
+}
+
+// slotWidgets concatenates the widget ids of the slot and its descendants.
+func slotWidgets(slot *html.Node) string {
+	var widgets []string
+	for _, attr := range []string{"data-cel-widget", "cel_widget_id", "data-component-type"} {
+		if value := htmlquery.SelectAttr(slot, attr); value != "" {
+			widgets = append(widgets, value)
+		}
+
+		nodes, err := utils.FindNodes(slot, fmt.Sprintf(`//*[@%v]`, attr), true)
+		if err != nil {
+			continue
+		}
+		for _, node := range nodes {
+			widgets = append(widgets, htmlquery.SelectAttr(node, attr))
+		}
+	}
+	return strings.Join(widgets, " ")
+}
+
+// isSponsoredSlot reports whether the slot carries an ad marker.
+func isSponsoredSlot(slot *html.Node, labels placementLabels) bool {
+	if strings.Contains(htmlquery.SelectAttr(slot, "class"), "AdHolder") {
+		return true
+	}
+
+	exprs := []string{
+		`//span[contains(@class, 'sponsored-label')]`,
+		fmt.Sprintf(`//span[normalize-space(text())="%v"]`, labels.sponsored),
+	}
+	for _, expr := range exprs {
+		if _, err := utils.FindNodes(slot, expr, true); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// slotASINs returns the distinct ASINs shown in a slot in document order.
+func slotASINs(slot *html.Node) []string {
+	if asin := htmlquery.SelectAttr(slot, "data-asin"); asin != "" {
+		return []string{asin}
+	}
+
+	var asins []string
+	seen := make(map[string]bool)
+	add := func(asin string) {
+		if asin != "" && !seen[asin] {
+			seen[asin] = true
+			asins = append(asins, asin)
+		}
+	}
+
+	nodes, err := utils.FindNodes(slot, `//*[@data-asin and string-length(@data-asin) > 0]`, true)
+	if err == nil {
+		for _, node := range nodes {
+			add(htmlquery.SelectAttr(node, "data-asin"))
+		}
+	}
+
+	if len(asins) == 0 {
+		links, err := utils.FindNodes(slot, `//a[contains(@href, '/dp/')]`, true)
+		if err == nil {
+			for _, link := range links {
+				if matches := dpRegex.FindStringSubmatch(htmlquery.SelectAttr(link, "href")); len(matches) > 1 {
+					add(matches[1])
+				}
+			}
+		}
+	}
+	return asins
+}
+
+// parseBadges returns the badges shown in a slot.
+func parseBadges(slot *html.Node, labels placementLabels) []string {
+	badges := map[string][]string{
+		model.BadgeAmazonsChoice: {
+			`//span[contains(@id, 'amazons-choice')]`,
+			fmt.Sprintf(`//span[contains(@aria-label, "%v") or contains(text(), "%v")]`, labels.amazonsChoice, labels.amazonsChoice),
+		},
+		model.BadgeBestSeller: {
+			`//span[contains(@id, 'best-seller')]`,
+			fmt.Sprintf(`//span[contains(text(), "%v")]`, labels.bestSeller),
+		},
+		model.BadgeHighlyRated: {
+			fmt.Sprintf(`//span[contains(text(), "%v")]`, labels.highlyRated),
+		},
+	}
+
+	var found []string
+	for _, badge := range []string{model.BadgeAmazonsChoice, model.BadgeBestSeller, model.BadgeHighlyRated} {
+		for _, expr := range badges[badge] {
+			if _, err := utils.FindNodes(slot, expr, true); err == nil {
+				found = append(found, badge)
+				break
+			}
+		}
+	}
+	return found
+}
@@ -4,19 +4,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"golang.org/x/net/html"
 
 	"github.com/antchfx/htmlquery"
 	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/internal/review"
+	"github.com/microsuite/go-amz-parser/model"
 	"github.com/microsuite/go-amz-parser/utils"
 )
 
-type FRProductParser struct{}
+type FRProductParser struct {
+	reviewParser *review.FRReviewParser
+}
 
 func NewFRProductParser() *FRProductParser {
-	return &FRProductParser{}
+	return &FRProductParser{
+		reviewParser: review.NewFRReviewParser(),
+	}
 }
 
 func (p *FRProductParser) ParseASIN(doc *html.Node) (string, error) {
@@ -325,6 +333,7 @@ func (p *FRProductParser) ParseSpecs(doc *html.Node) ([]string, error) {
 		for key := range m {
 			specs = append(specs, key)
 		}
+		sort.Strings(specs)
 	}
 	return specs, nil
 }
@@ -414,14 +423,112 @@ func (p *FRProductParser) ParseCustomerReviews(doc *html.Node) (map[string]strin
 			continue
 		}
 
-		var percentage string
+		percentage := "unknown"
 		rightNodes, err := utils.FindNodes(node, rightExpr, false)
-		if err != nil {
-			percentage = "unknown"
+		if err == nil {
+			percentage = strings.TrimSpace(rightNodes[0].Data)
 		}
-		percentage = strings.TrimSpace(rightNodes[0].Data)
 
-		customerReviews[strings.TrimSpace(leftNodes[0].Data)] = strings.TrimSpace(percentage)
+		customerReviews[strings.TrimSpace(leftNodes[0].Data)] = percentage
 	}
 	return customerReviews, nil
 }
+
+// ParsePriceInfo parses the price, list price, deal and coupon from the given HTML document.
+func (p *FRProductParser) ParsePriceInfo(doc *html.Node) (*model.PriceInfo, error) {
+	info := &model.PriceInfo{}
+
+	info.Price = findMoney(doc, []string{
+		`//div[starts-with(@id, "corePrice")]//span[contains(@class, 'priceToPay')]/span[@class='a-offscreen']/text()`,
+		`//div[starts-with(@id, "corePrice")]//span[@data-a-color='price']/span[@class='a-offscreen']/text()`,
+		`//span[starts-with(@class, 'a-price') and @data-a-color="price"]/span/text()`,
+	})
+
+	info.ListPrice = findMoney(doc, []string{
+		`//span[contains(@class, 'basisPrice')]//span[@data-a-strike='true']/span[@class='a-offscreen']/text()`,
+		`//span[contains(text(), 'Prix conseillé')]/..//span[@data-a-strike='true']/span[@class='a-offscreen']/text()`,
+		`//span[contains(text(), 'Prix de référence')]/..//span[@data-a-strike='true']/span[@class='a-offscreen']/text()`,
+		`//span[contains(text(), 'Ancien prix')]/..//span[@data-a-strike='true']/span[@class='a-offscreen']/text()`,
+		`//div[starts-with(@id, "corePrice")]//span[@data-a-strike='true']/span[@class='a-offscreen']/text()`,
+	})
+
+	if savings, ok := findPercent(doc, []string{
+		`//div[starts-with(@id, "corePrice")]//span[contains(@class, 'savingsPercentage')]/text()`,
+	}); ok {
+		info.SavingsPercent = int(savings)
+	}
+
+	info.DealBadge = findText(doc, []string{
+		`//div[@id='dealBadge_feature_div']//span[contains(text(), 'Vente Flash')]/text()`,
+		`//div[@id='dealBadge_feature_div']//span[contains(text(), 'Offre à durée limitée')]/text()`,
+		`//div[@id='dealBadge_feature_div']//span[contains(text(), 'Offre')]/text()`,
+	})
+
+	if strings.Contains(info.DealBadge, "Vente Flash") {
+		deal := &model.LightningDeal{}
+		if claimed, ok := findPercent(doc, []string{
+			`//span[contains(text(), 'réclamé')]/text()`,
+		}); ok {
+			deal.ClaimedPercent = int(claimed)
+		}
+		endsIn := findText(doc, []string{
+			`//span[contains(text(), 'Se termine dans')]/text()`,
+		})
+		deal.EndsIn = strings.TrimSpace(strings.TrimPrefix(endsIn, "Se termine dans"))
+		info.LightningDeal = deal
+	}
+
+	info.SubscribeSavePrice = findMoney(doc, []string{
+		`//div[contains(@id, 'snsAccordionRow')]//span[@class='a-offscreen']/text()`,
+		`//span[@id='sns-base-price']/text()`,
+	})
+
+	if coupon, err := p.ParseCoupon(doc); err == nil {
+		info.Coupon = utils.ParseCoupon(coupon)
+	}
+
+	if info.Price == nil && info.ListPrice == nil && info.SubscribeSavePrice == nil {
+		return nil, errors.ErrorNotFoundPrice
+	}
+	return info, nil
+}
+
+// ParseRatingHistogram parses the star histogram, total ratings and average star from the given HTML document.
+func (p *FRProductParser) ParseRatingHistogram(doc *html.Node) (*model.RatingHistogram, error) {
+	histogram, err := parseHistogram(doc, "étoile")
+	if err != nil {
+		return nil, err
+	}
+
+	if histogram.TotalRatings == 0 {
+		if rating, err := p.ParseRating(doc); err == nil {
+			histogram.TotalRatings, _ = strconv.Atoi(rating)
+		}
+	}
+	if histogram.Average == 0 {
+		if star, err := p.ParseStar(doc); err == nil {
+			histogram.Average, _ = utils.ParseAmount(star)
+		}
+	}
+	return histogram, nil
+}
+
+// ParseReviewInsights parses the "Les clients disent" summary and aspects from the given HTML document.
+func (p *FRProductParser) ParseReviewInsights(doc *html.Node) (*model.ReviewInsights, error) {
+	return parseInsights(doc, insightsLabels{
+		heading:  "Les clients disent",
+		mentions: "clients mentionnent",
+		positive: "positi",
+		negative: "négati",
+	})
+}
+
+// ParseLocalReviews parses the top reviews from the region of the given HTML document.
+func (p *FRProductParser) ParseLocalReviews(doc *html.Node) ([]*model.Review, error) {
+	return parseEmbeddedReviews(doc, localReviewsExpr, p.reviewParser.ParseReview)
+}
+
+// ParseForeignReviews parses the top reviews from other countries of the given HTML document.
+func (p *FRProductParser) ParseForeignReviews(doc *html.Node) ([]*model.Review, error) {
+	return parseEmbeddedReviews(doc, foreignReviewsExpr, p.reviewParser.ParseReview)
+}
@@ -0,0 +1,81 @@
+package product
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+// starCountRegex matches a star count of a histogram row, which must be
+// followed by the localized star word.
+var starCountRegex = regexp.MustCompile(`[1-5]\s*`)
+
+// parseHistogram parses the star histogram of the customer reviews block.
+// starWord is the localized word following the star count, e.g. "star".
+func parseHistogram(doc *html.Node, starWord string) (*model.RatingHistogram, error) {
+	exprs := []string{
+		`//*[@id='histogramTable']//li`,
+		`//*[@id='histogramTable']//tr`,
+		`//li[@class='a-align-center a-spacing-none']`,
+	}
+
+	histogram := &model.RatingHistogram{}
+	found := false
+	for _, expr := range exprs {
+		rows, err := utils.FindNodes(doc, expr, true)
+		if err != nil {
+			continue
+		}
+
+		for _, row := range rows {
+			text := strings.Join(strings.Fields(htmlquery.InnerText(row)), " ")
+			loc := findBefore(starCountRegex, text, starWord)
+			if loc == nil {
+				continue
+			}
+
+			percent, err := utils.ParsePercent(text[:loc[0]] + text[loc[1]+len(starWord):])
+			if err != nil {
+				continue
+			}
+			histogram.Percentages[text[loc[0]]-'1'] = percent
+			found = true
+		}
+		if found {
+			break
+		}
+	}
+
+	if nodes, err := utils.FindNodes(doc, `//span[@data-hook='total-review-count']/text()`, true); err == nil {
+		if total, err := utils.ParseAmount(nodes[0].Data); err == nil {
+			histogram.TotalRatings = int(total)
+		}
+	}
+	if nodes, err := utils.FindNodes(doc, `//span[@data-hook='rating-out-of-text']/text()`, true); err == nil {
+		if average, err := utils.ParseAmount(nodes[0].Data); err == nil {
+			histogram.Average = average
+		}
+	}
+
+	if !found {
+		return histogram, errors.ErrorNotFoundHistogram
+	}
+	return histogram, nil
+}
+
+// findBefore returns the location of the first match of regex in text that
+// is directly followed by word, or nil.
+func findBefore(regex *regexp.Regexp, text string, word string) []int {
+	for _, loc := range regex.FindAllStringIndex(text, -1) {
+		if strings.HasPrefix(text[loc[1]:], word) {
+			return loc
+		}
+	}
+	return nil
+}
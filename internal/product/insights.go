@@ -0,0 +1,134 @@
+package product
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+// countRegex matches a count of an aspect chip, which must be followed by
+// the localized label.
+var countRegex = regexp.MustCompile(`\d[\d.,]*\s*`)
+
+// insightsLabels holds the localized texts of the "Customers say" block.
+type insightsLabels struct {
+	heading  string
+	mentions string
+	positive string
+	negative string
+}
+
+// parseInsights parses the review summary and aspect chips.
+func parseInsights(doc *html.Node, labels insightsLabels) (*model.ReviewInsights, error) {
+	insights := &model.ReviewInsights{}
+
+	summaryExprs := []string{
+		`//div[@id='product-summary']//p//text()`,
+		`//*[@data-hook='cr-insights-widget-summary']//p//text()`,
+		fmt.Sprintf(`//h3[contains(text(), "%v")]/following::p[1]//text()`, labels.heading),
+	}
+	for _, expr := range summaryExprs {
+		nodes, err := utils.FindNodes(doc, expr, true)
+		if err != nil {
+			continue
+		}
+
+		var parts []string
+		for _, node := range nodes {
+			if text := strings.TrimSpace(node.Data); text != "" {
+				parts = append(parts, text)
+			}
+		}
+		if len(parts) > 0 {
+			insights.Summary = strings.Join(parts, " ")
+			break
+		}
+	}
+
+	aspectExprs := []string{
+		`//*[@data-hook='cr-insights-widget-aspects']//button`,
+		`//div[contains(@id, 'aspect-button-group')]//a`,
+	}
+	for _, expr := range aspectExprs {
+		nodes, err := utils.FindNodes(doc, expr, true)
+		if err != nil {
+			continue
+		}
+
+		for _, node := range nodes {
+			if aspect := parseAspect(doc, node, labels); aspect != nil {
+				insights.Aspects = append(insights.Aspects, aspect)
+			}
+		}
+		if len(insights.Aspects) > 0 {
+			break
+		}
+	}
+
+	if insights.Summary == "" && len(insights.Aspects) == 0 {
+		return nil, errors.ErrorNotFoundReviewInsights
+	}
+	return insights, nil
+}
+
+// parseAspect parses a single aspect chip and the mention counts found in
+// its aria-label or in the element it describes.
+func parseAspect(doc *html.Node, node *html.Node, labels insightsLabels) *model.ReviewAspect {
+	name := strings.Join(strings.Fields(htmlquery.InnerText(node)), " ")
+	if name == "" {
+		return nil
+	}
+	aspect := &model.ReviewAspect{Name: name, Sentiment: model.SentimentMixed}
+
+	classes := strings.ToLower(htmlquery.SelectAttr(node, "class"))
+	if icons, err := utils.FindNodes(node, `//i[@class]`, true); err == nil {
+		classes += " " + strings.ToLower(htmlquery.SelectAttr(icons[0], "class"))
+	}
+	switch {
+	case strings.Contains(classes, "positive"):
+		aspect.Sentiment = model.SentimentPositive
+	case strings.Contains(classes, "negative"):
+		aspect.Sentiment = model.SentimentNegative
+	}
+
+	text := htmlquery.SelectAttr(node, "aria-label")
+	for _, attr := range []string{"aria-describedby", "aria-controls"} {
+		id := htmlquery.SelectAttr(node, attr)
+		if id == "" {
+			continue
+		}
+		if nodes, err := utils.FindNodes(doc, fmt.Sprintf(`//*[@id='%v']`, id), true); err == nil {
+			text += " " + htmlquery.InnerText(nodes[0])
+		}
+	}
+
+	aspect.Mentions = findCount(text, labels.mentions)
+	aspect.Positive = findCount(text, labels.positive)
+	aspect.Negative = findCount(text, labels.negative)
+	if aspect.Mentions == 0 {
+		aspect.Mentions = aspect.Positive + aspect.Negative
+	}
+	return aspect
+}
+
+// findCount returns the number right before word in text, or 0.
+func findCount(text string, word string) int {
+	loc := findBefore(countRegex, text, word)
+	if loc == nil {
+		return 0
+	}
+
+	count, err := strconv.Atoi(strings.NewReplacer(",", "", ".", "").Replace(strings.TrimSpace(text[loc[0]:loc[1]])))
+	if err != nil {
+		return 0
+	}
+	return count
+}
@@ -0,0 +1,61 @@
+package product
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+// findMoney returns the first money value matched by exprs.
+func findMoney(doc *html.Node, exprs []string) *model.Money {
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(doc, expr, true)
+		if err != nil {
+			continue
+		}
+
+		for _, node := range nodes {
+			if money, err := utils.ParseMoney(node.Data); err == nil {
+				return money
+			}
+		}
+	}
+	return nil
+}
+
+// findText returns the first non blank text matched by exprs.
+func findText(doc *html.Node, exprs []string) string {
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(doc, expr, true)
+		if err != nil {
+			continue
+		}
+
+		for _, node := range nodes {
+			if text := strings.TrimSpace(node.Data); text != "" {
+				return text
+			}
+		}
+	}
+	return ""
+}
+
+// findPercent returns the first percentage matched by exprs.
+func findPercent(doc *html.Node, exprs []string) (float64, bool) {
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(doc, expr, true)
+		if err != nil {
+			continue
+		}
+
+		for _, node := range nodes {
+			if percent, err := utils.ParsePercent(node.Data); err == nil {
+				return percent, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,35 @@
+package product
+
+import (
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+const (
+	localReviewsExpr   = `//div[@id='cm-cr-dp-review-list']//*[@data-hook='review']`
+	foreignReviewsExpr = `//div[@id='cm-cr-global-review-list']//*[@data-hook='review']`
+)
+
+// parseEmbeddedReviews parses the top reviews matched by expr with the
+// review parser of the region.
+func parseEmbeddedReviews(doc *html.Node, expr string, parse func(*html.Node) (*model.Review, error)) ([]*model.Review, error) {
+	nodes, err := utils.FindNodes(doc, expr, true)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]*model.Review, 0, len(nodes))
+	for _, node := range nodes {
+		if review, err := parse(node); err == nil {
+			reviews = append(reviews, review)
+		}
+	}
+
+	if len(reviews) == 0 {
+		return nil, errors.ErrorNotFoundReview
+	}
+	return reviews, nil
+}
@@ -4,19 +4,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"golang.org/x/net/html"
 
 	"github.com/antchfx/htmlquery"
 	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/internal/review"
+	"github.com/microsuite/go-amz-parser/model"
 	"github.com/microsuite/go-amz-parser/utils"
 )
 
-type UKProductParser struct{}
+type UKProductParser struct {
+	reviewParser *review.UKReviewParser
+}
 
 func NewUKProductParser() *UKProductParser {
-	return &UKProductParser{}
+	return &UKProductParser{
+		reviewParser: review.NewUKReviewParser(),
+	}
 }
 
 func (p *UKProductParser) ParseASIN(doc *html.Node) (string, error) {
@@ -324,6 +332,7 @@ func (p *UKProductParser) ParseSpecs(doc *html.Node) ([]string, error) {
 		for key := range m {
 			specs = append(specs, key)
 		}
+		sort.Strings(specs)
 	}
 	return specs, nil
 }
@@ -413,14 +422,112 @@ func (p *UKProductParser) ParseCustomerReviews(doc *html.Node) (map[string]strin
 			continue
 		}
 
-		var percentage string
+		percentage := "unknown"
 		rightNodes, err := utils.FindNodes(node, rightExpr, false)
-		if err != nil {
-			percentage = "unknown"
+		if err == nil {
+			percentage = strings.TrimSpace(rightNodes[0].Data)
 		}
-		percentage = strings.TrimSpace(rightNodes[0].Data)
 
-		customerReviews[strings.TrimSpace(leftNodes[0].Data)] = strings.TrimSpace(percentage)
+		customerReviews[strings.TrimSpace(leftNodes[0].Data)] = percentage
 	}
 	return customerReviews, nil
 }
+
+// ParsePriceInfo parses the price, list price, deal and coupon from the given HTML document.
+func (p *UKProductParser) ParsePriceInfo(doc *html.Node) (*model.PriceInfo, error) {
+	info := &model.PriceInfo{}
+
+	info.Price = findMoney(doc, []string{
+		`//div[starts-with(@id, "corePrice")]//span[contains(@class, 'priceToPay')]/span[@class='a-offscreen']/text()`,
+		`//div[starts-with(@id, "corePrice")]//span[@data-a-color='price']/span[@class='a-offscreen']/text()`,
+		`//span[starts-with(@class, 'a-price') and @data-a-color="price"]/span/text()`,
+	})
+
+	info.ListPrice = findMoney(doc, []string{
+		`//span[contains(@class, 'basisPrice')]//span[@data-a-strike='true']/span[@class='a-offscreen']/text()`,
+		`//span[contains(text(), 'RRP')]/..//span[@data-a-strike='true']/span[@class='a-offscreen']/text()`,
+		`//span[contains(text(), 'Was')]/..//span[@data-a-strike='true']/span[@class='a-offscreen']/text()`,
+		`//span[contains(text(), 'Typical price')]/..//span[@data-a-strike='true']/span[@class='a-offscreen']/text()`,
+		`//div[starts-with(@id, "corePrice")]//span[@data-a-strike='true']/span[@class='a-offscreen']/text()`,
+	})
+
+	if savings, ok := findPercent(doc, []string{
+		`//div[starts-with(@id, "corePrice")]//span[contains(@class, 'savingsPercentage')]/text()`,
+	}); ok {
+		info.SavingsPercent = int(savings)
+	}
+
+	info.DealBadge = findText(doc, []string{
+		`//div[@id='dealBadge_feature_div']//span[contains(text(), 'Lightning Deal')]/text()`,
+		`//div[@id='dealBadge_feature_div']//span[contains(text(), 'Limited time deal')]/text()`,
+		`//div[@id='dealBadge_feature_div']//span[contains(text(), 'Deal')]/text()`,
+	})
+
+	if strings.Contains(info.DealBadge, "Lightning Deal") {
+		deal := &model.LightningDeal{}
+		if claimed, ok := findPercent(doc, []string{
+			`//span[contains(text(), 'claimed')]/text()`,
+		}); ok {
+			deal.ClaimedPercent = int(claimed)
+		}
+		endsIn := findText(doc, []string{
+			`//span[contains(text(), 'Ends in')]/text()`,
+		})
+		deal.EndsIn = strings.TrimSpace(strings.TrimPrefix(endsIn, "Ends in"))
+		info.LightningDeal = deal
+	}
+
+	info.SubscribeSavePrice = findMoney(doc, []string{
+		`//div[contains(@id, 'snsAccordionRow')]//span[@class='a-offscreen']/text()`,
+		`//span[@id='sns-base-price']/text()`,
+	})
+
+	if coupon, err := p.ParseCoupon(doc); err == nil {
+		info.Coupon = utils.ParseCoupon(coupon)
+	}
+
+	if info.Price == nil && info.ListPrice == nil && info.SubscribeSavePrice == nil {
+		return nil, errors.ErrorNotFoundPrice
+	}
+	return info, nil
+}
+
+// ParseRatingHistogram parses the star histogram, total ratings and average star from the given HTML document.
+func (p *UKProductParser) ParseRatingHistogram(doc *html.Node) (*model.RatingHistogram, error) {
+	histogram, err := parseHistogram(doc, "star")
+	if err != nil {
+		return nil, err
+	}
+
+	if histogram.TotalRatings == 0 {
+		if rating, err := p.ParseRating(doc); err == nil {
+			histogram.TotalRatings, _ = strconv.Atoi(rating)
+		}
+	}
+	if histogram.Average == 0 {
+		if star, err := p.ParseStar(doc); err == nil {
+			histogram.Average, _ = utils.ParseAmount(star)
+		}
+	}
+	return histogram, nil
+}
+
+// ParseReviewInsights parses the "Customers say" summary and aspects from the given HTML document.
+func (p *UKProductParser) ParseReviewInsights(doc *html.Node) (*model.ReviewInsights, error) {
+	return parseInsights(doc, insightsLabels{
+		heading:  "Customers say",
+		mentions: "customers mention",
+		positive: "positive",
+		negative: "negative",
+	})
+}
+
+// ParseLocalReviews parses the top reviews from the region of the given HTML document.
+func (p *UKProductParser) ParseLocalReviews(doc *html.Node) ([]*model.Review, error) {
+	return parseEmbeddedReviews(doc, localReviewsExpr, p.reviewParser.ParseReview)
+}
+
+// ParseForeignReviews parses the top reviews from other countries of the given HTML document.
+func (p *UKProductParser) ParseForeignReviews(doc *html.Node) ([]*model.Review, error) {
+	return parseEmbeddedReviews(doc, foreignReviewsExpr, p.reviewParser.ParseReview)
+}
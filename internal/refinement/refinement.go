@@ -0,0 +1,132 @@
+package refinement
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+// Parse parses the refinement groups of the left navigation. The markup of
+// the refinements is the same in every region, only the labels differ.
+func Parse(doc *html.Node) ([]*model.RefinementGroup, error) {
+	exprs := []string{
+		`//div[@id='s-refinements']/div[@id and .//li]`,
+		`//div[contains(@id, 'Refinements') and .//li]`,
+	}
+
+	var nodes []*html.Node
+	var err error
+	for _, expr := range exprs {
+		nodes, err = utils.FindNodes(doc, expr, true)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, errors.ErrorNotFoundRefinements
+	}
+
+	groups := make([]*model.RefinementGroup, 0, len(nodes))
+	for _, node := range nodes {
+		group := &model.RefinementGroup{
+			ID:   htmlquery.SelectAttr(node, "id"),
+			Name: parseName(node),
+		}
+
+		items, err := utils.FindNodes(node, `//li`, true)
+		if err != nil {
+			continue
+		}
+		for _, item := range items {
+			if option := parseOption(item); option != nil {
+				group.Options = append(group.Options, option)
+			}
+		}
+
+		if len(group.Options) > 0 {
+			groups = append(groups, group)
+		}
+	}
+
+	if len(groups) == 0 {
+		return nil, errors.ErrorNotFoundRefinements
+	}
+	return groups, nil
+}
+
+// parseName parses the heading of a refinement group.
+func parseName(group *html.Node) string {
+	expr := `//span[not(ancestor::li) and string-length(normalize-space(text())) > 0]/text()`
+	nodes, err := utils.FindNodes(group, expr, true)
+	if err != nil {
+		return "unknown"
+	}
+	return strings.TrimSpace(nodes[0].Data)
+}
+
+// parseOption parses a single refinement option from a list item.
+func parseOption(item *html.Node) *model.RefinementOption {
+	option := &model.RefinementOption{
+		ID:    htmlquery.SelectAttr(item, "id"),
+		Count: -1,
+	}
+
+	exprs := []string{
+		`//span[contains(@class, 'a-color-base') and not(contains(@class, 'a-color-secondary'))]/text()`,
+		`//a/@aria-label`,
+		`//@aria-label`,
+	}
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(item, expr, true)
+		if err != nil {
+			continue
+		}
+
+		if nodes[0].Type == html.TextNode {
+			option.Label = strings.TrimSpace(nodes[0].Data)
+		} else {
+			option.Label = strings.TrimSpace(htmlquery.InnerText(nodes[0]))
+		}
+		if option.Label != "" {
+			break
+		}
+	}
+	if option.Label == "" {
+		option.Label = strings.Join(strings.Fields(htmlquery.InnerText(item)), " ")
+	}
+	if option.Label == "" {
+		return nil
+	}
+
+	if nodes, err := utils.FindNodes(item, `//span[contains(@class, 'a-color-secondary')]/text()`, true); err == nil {
+		if count, err := utils.ParseAmount(nodes[0].Data); err == nil {
+			option.Count = int(count)
+		}
+	}
+
+	selectedExprs := []string{
+		`//input[@type='checkbox' and @checked]`,
+		`//a[@aria-current='true']`,
+		`//span[contains(@class, 'a-text-bold')]`,
+	}
+	for _, expr := range selectedExprs {
+		if _, err := utils.FindNodes(item, expr, true); err == nil {
+			option.Selected = true
+			break
+		}
+	}
+
+	if nodes, err := utils.FindNodes(item, `//a[@href]`, true); err == nil {
+		option.URL = html.UnescapeString(htmlquery.SelectAttr(nodes[0], "href"))
+		if u, err := url.Parse(option.URL); err == nil {
+			option.Filter = u.Query().Get("rh")
+		}
+	}
+	return option
+}
@@ -0,0 +1,188 @@
+package review
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+var reviewIDRegex = regexp.MustCompile(`^R[A-Z0-9]{8,}$`)
+
+// parseReviewID returns the review id carried by the review container or
+// its customer_review child.
+func parseReviewID(node *html.Node) (string, error) {
+	ids := []string{htmlquery.SelectAttr(node, "id")}
+	if nodes, err := utils.FindNodes(node, `//*[starts-with(@id, 'customer_review')]`, true); err == nil {
+		ids = append(ids, htmlquery.SelectAttr(nodes[0], "id"))
+	}
+
+	for _, id := range ids {
+		if i := strings.LastIndex(id, "-"); i >= 0 {
+			id = id[i+1:]
+		}
+		if reviewIDRegex.MatchString(id) {
+			return id, nil
+		}
+	}
+	return "unknown", errors.ErrorNotFoundReviewID
+}
+
+// parsePermalink returns the absolute url of the review, falling back to
+// the customer reviews url built from the review id.
+func parsePermalink(node *html.Node, domain string) (string, error) {
+	expr := `//a[@data-hook='review-title' and contains(@href, '/customer-reviews/')]`
+	if nodes, err := utils.FindNodes(node, expr, true); err == nil {
+		return canonicalLink(htmlquery.SelectAttr(nodes[0], "href"), domain), nil
+	}
+
+	id, err := parseReviewID(node)
+	if err != nil {
+		return "unknown", errors.ErrorNotFoundPermalink
+	}
+	return domain + "/gp/customer-reviews/" + id, nil
+}
+
+// reviewsExpr matches the review containers of both the legacy
+// div[data-hook=review] layout and the li layout, without nested matches.
+const reviewsExpr = `//*[(self::li or self::div) and (@data-hook='review' or @review) and not(ancestor::*[@data-hook='review' or @review])]`
+
+// parseAllReviews returns the review containers of the given HTML document.
+func parseAllReviews(doc *html.Node) ([]*html.Node, error) {
+	nodes, err := utils.FindNodes(doc, reviewsExpr, true)
+	if err != nil {
+		return nil, errors.ErrorNotFoundReview
+	}
+	return nodes, nil
+}
+
+// fieldParser is implemented by the review parsers of all regions.
+type fieldParser interface {
+	ParseReviewer(node *html.Node) (string, error)
+	ParseReviewerLink(node *html.Node) (string, error)
+	ParseStar(node *html.Node) (string, error)
+	ParseTitle(node *html.Node) (string, error)
+	ParseDate(node *html.Node) (string, error)
+	ParsePurchase(node *html.Node) (string, error)
+	ParseContent(node *html.Node) (string, error)
+	ParseHelpfulVotes(node *html.Node) (string, error)
+	ParseImages(node *html.Node) ([]string, error)
+	ParseVideos(node *html.Node) ([]string, error)
+	ParseVariant(node *html.Node) (string, error)
+	ParseVine(node *html.Node) (bool, error)
+	ParseEdited(node *html.Node) (bool, error)
+	ParseReviewID(node *html.Node) (string, error)
+	ParsePermalink(node *html.Node) (string, error)
+}
+
+// canonicalLink returns the absolute url of a link of the given domain
+// without its tracking /ref= suffix.
+func canonicalLink(link, domain string) string {
+	if i := strings.Index(link, "/ref="); i >= 0 {
+		link = link[:i]
+	}
+	if strings.HasPrefix(link, "/") {
+		link = domain + link
+	}
+	return link
+}
+
+// parseReview parses all fields of the review with the field parsers of
+// the region. Fields that are not found are left empty, links are made
+// absolute to the domain of the region.
+func parseReview(p fieldParser, node *html.Node, domain string) (*model.Review, error) {
+	review := &model.Review{}
+
+	title, titleErr := p.ParseTitle(node)
+	content, contentErr := p.ParseContent(node)
+	if titleErr != nil && contentErr != nil {
+		return nil, errors.ErrorNotFoundReview
+	}
+	if titleErr == nil {
+		review.Title = title
+	}
+	if contentErr == nil {
+		review.Content = content
+	}
+
+	if id, err := p.ParseReviewID(node); err == nil {
+		review.ID = id
+	}
+	if permalink, err := p.ParsePermalink(node); err == nil {
+		review.Permalink = permalink
+	}
+	if reviewer, err := p.ParseReviewer(node); err == nil {
+		review.Reviewer = reviewer
+	}
+	if link, err := p.ParseReviewerLink(node); err == nil {
+		review.ReviewerLink = canonicalLink(link, domain)
+	}
+	if date, err := p.ParseDate(node); err == nil {
+		review.Date = date
+	}
+	if star, err := p.ParseStar(node); err == nil {
+		review.Star, _ = strconv.ParseFloat(star, 64)
+	}
+	if _, err := p.ParsePurchase(node); err == nil {
+		review.Verified = true
+	}
+	if votes, err := p.ParseHelpfulVotes(node); err == nil {
+		review.HelpfulVotes, _ = strconv.Atoi(votes)
+	}
+	review.Images, _ = p.ParseImages(node)
+	review.Videos, _ = p.ParseVideos(node)
+	if variant, err := p.ParseVariant(node); err == nil {
+		review.Variant = variant
+	}
+	review.Vine, _ = p.ParseVine(node)
+	review.Edited, _ = p.ParseEdited(node)
+	return review, nil
+}
+
+// parseReviewer returns the profile name of the reviewer.
+func parseReviewer(node *html.Node) (string, error) {
+	expr := `//*[contains(concat(' ', normalize-space(@class), ' '), ' a-profile ')]//span[contains(@class, 'a-profile-name')]/text()`
+	nodes, err := utils.FindNodes(node, expr, true)
+	if err != nil {
+		return "unknown", errors.ErrorNotFoundReviewer
+	}
+
+	reviewer := strings.TrimSpace(nodes[0].Data)
+	if reviewer == "" {
+		return "unknown", errors.ErrorNotFoundReviewer
+	}
+	return reviewer, nil
+}
+
+// parseReviewerLink returns the href of the reviewer profile.
+func parseReviewerLink(node *html.Node) (string, error) {
+	expr := `//a[contains(concat(' ', normalize-space(@class), ' '), ' a-profile ') and @href]`
+	nodes, err := utils.FindNodes(node, expr, true)
+	if err != nil {
+		return "unknown", errors.ErrorNotFoundReviewerLink
+	}
+
+	link := htmlquery.SelectAttr(nodes[0], "href")
+	if link == "" {
+		return "unknown", errors.ErrorNotFoundReviewerLink
+	}
+	return link, nil
+}
+
+// parsePurchase returns the text of the verified purchase badge, which must
+// contain the localized label, e.g. "Verified Purchase".
+func parsePurchase(node *html.Node, label string) (string, error) {
+	expr := fmt.Sprintf(`//span[@data-hook='avp-badge' or @data-hook='avp-badge-linkless'][contains(normalize-space(.), "%v")]`, label)
+	nodes, err := utils.FindNodes(node, expr, true)
+	if err != nil {
+		return "unknown", errors.ErrorNotFoundPurchase
+	}
+	return strings.TrimSpace(htmlquery.InnerText(nodes[0])), nil
+}
@@ -5,46 +5,30 @@ import (
 
 	"github.com/antchfx/htmlquery"
 	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
 	"github.com/microsuite/go-amz-parser/utils"
 	"golang.org/x/net/html"
 )
 
+const usDomain = "https://www.amazon.com"
+
 type USReviewParser struct{}
 
 func NewUSReviewParser() *USReviewParser {
 	return &USReviewParser{}
 }
 
+// ParseAllReviews parses the review containers from the given HTML document.
 func (p *USReviewParser) ParseAllReviews(doc *html.Node) ([]*html.Node, error) {
-	expr := "//body/li[contains(@data-hook, 'review')]"
-	nodes, err := utils.FindNodes(doc, expr, true)
-	if err != nil {
-		return nil, err
-	}
-	return nodes, nil
+	return parseAllReviews(doc)
 }
 
 func (p *USReviewParser) ParseReviewer(node *html.Node) (string, error) {
-	expr := `//div[contains(@id, 'customer_review')]/div/a/div/span/text()`
-	nodes, err := utils.FindNodes(node, expr, true)
-	if err != nil {
-		return "unknown", err
-	}
-	return strings.TrimSpace(nodes[0].Data), nil
+	return parseReviewer(node)
 }
 
 func (p *USReviewParser) ParseReviewerLink(node *html.Node) (string, error) {
-	expr := `//div[contains(@id, 'customer_review')]/div/a/@href`
-	nodes, err := utils.FindNodes(node, expr, true)
-	if err != nil {
-		return "unknown", err
-	}
-
-	link := htmlquery.SelectAttr(nodes[0], "href")
-	if link == "" {
-		return "unknown", errors.ErrorNotFoundReviewerLink
-	}
-	return link, nil
+	return parseReviewerLink(node)
 }
 
 func (p *USReviewParser) ParseStar(node *html.Node) (string, error) {
@@ -59,12 +43,18 @@ func (p *USReviewParser) ParseStar(node *html.Node) (string, error) {
 }
 
 func (p *USReviewParser) ParseTitle(node *html.Node) (string, error) {
-	expr := `//a[@review-title]/span/text()`
-	nodes, err := utils.FindNodes(node, expr, true)
-	if err != nil {
-		return "unknown", err
+	exprs := []string{
+		`//a[@review-title]/span/text()`,
+		`//*[@data-hook='review-title']/span[string-length(normalize-space(text())) > 0]/text()`, // ProductOfTopReviews
+	}
+
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(node, expr, true)
+		if err == nil {
+			return strings.TrimSpace(nodes[0].Data), nil
+		}
 	}
-	return strings.TrimSpace(nodes[0].Data), nil
+	return "unknown", errors.ErrorNotFoundTitle
 }
 
 func (p *USReviewParser) ParseDate(node *html.Node) (string, error) {
@@ -76,26 +66,144 @@ func (p *USReviewParser) ParseDate(node *html.Node) (string, error) {
 
 	date := strings.TrimSpace(nodes[0].Data)
 	dates := strings.Split(date, "Reviewed in the United States on")
-	if len(dates) < 1 {
-		return "unknown", errors.ErrorNotFoundReviewerLink
+	if len(dates) < 2 {
+		// reviews from other countries name their own country.
+		index := strings.LastIndex(date, " on ")
+		if index < 0 {
+			return "unknown", errors.ErrorNotFoundReviewDate
+		}
+		return strings.TrimSpace(date[index+len(" on "):]), nil
 	}
 	return strings.TrimSpace(dates[1]), nil
 }
 
 func (p *USReviewParser) ParsePurchase(node *html.Node) (string, error) {
-	expr := `//div/a[contains(@class, 'a-link-normal')]/span/text()`
+	return parsePurchase(node, "Verified Purchase")
+}
+
+func (p *USReviewParser) ParseContent(node *html.Node) (string, error) {
+	exprs := []string{
+		`//div[@review-text-content]/span/text()`,
+		`//*[@data-hook='review-body']//span/text()`, // ProductOfTopReviews
+	}
+
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(node, expr, true)
+		if err == nil {
+			return strings.TrimSpace(nodes[0].Data), nil
+		}
+	}
+	return "unknown", errors.ErrorNotFoundContent
+}
+
+// ParseReview parses all fields of the review from the given HTML node.
+func (p *USReviewParser) ParseReview(node *html.Node) (*model.Review, error) {
+	return parseReview(p, node, usDomain)
+}
+
+// ParseHelpfulVotes parses the number of people who found the review helpful from the given HTML node.
+func (p *USReviewParser) ParseHelpfulVotes(node *html.Node) (string, error) {
+	expr := `//span[contains(@data-hook, 'helpful-vote-statement') and contains(text(), 'found this helpful')]/text()`
 	nodes, err := utils.FindNodes(node, expr, true)
 	if err != nil {
-		return "unknown", err
+		return "0", err
 	}
-	return strings.TrimSpace(nodes[0].Data), nil
+
+	votes := strings.TrimSpace(nodes[0].Data)
+	if strings.HasPrefix(votes, "One person") {
+		return "1", nil
+	}
+	return utils.FormatNumber(utils.FindNumberHead(votes)), nil
 }
 
-func (p *USReviewParser) ParseContent(node *html.Node) (string, error) {
-	expr := `//div[@review-text-content]/span/text()`
+// ParseImages parses the customer image urls from the given HTML node.
+func (p *USReviewParser) ParseImages(node *html.Node) ([]string, error) {
+	exprs := []string{
+		`//img[@data-hook='review-image-tile']`,
+		`//div[contains(@class, 'review-image-tile-section')]//img`,
+	}
+
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(node, expr, true)
+		if err == nil {
+			var images []string
+			for _, n := range nodes {
+				if src := htmlquery.SelectAttr(n, "src"); src != "" {
+					images = append(images, src)
+				}
+			}
+			return images, nil
+		}
+	}
+	return nil, errors.ErrorNotFoundImgURL
+}
+
+// ParseVideos parses the customer video urls from the given HTML node.
+func (p *USReviewParser) ParseVideos(node *html.Node) ([]string, error) {
+	expr := `//*[@data-video-url and string-length(@data-video-url) > 0]`
+	nodes, err := utils.FindNodes(node, expr, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var videos []string
+	for _, n := range nodes {
+		videos = append(videos, htmlquery.SelectAttr(n, "data-video-url"))
+	}
+	return videos, nil
+}
+
+// ParseVariant parses the reviewed variant, e.g. "Color: Black | Size: L", from the given HTML node.
+func (p *USReviewParser) ParseVariant(node *html.Node) (string, error) {
+	expr := `//*[@data-hook='format-strip' or @data-hook='format-strip-linkless']//text()`
 	nodes, err := utils.FindNodes(node, expr, true)
 	if err != nil {
 		return "unknown", err
 	}
-	return strings.TrimSpace(nodes[0].Data), nil
+
+	var variants []string
+	for _, n := range nodes {
+		if variant := strings.TrimSpace(n.Data); variant != "" && variant != "|" {
+			variants = append(variants, variant)
+		}
+	}
+	if len(variants) == 0 {
+		return "unknown", errors.ErrorNotFoundVariant
+	}
+	return strings.Join(variants, " | "), nil
+}
+
+// ParseVine parses whether the review is a Vine customer review from the given HTML node.
+func (p *USReviewParser) ParseVine(node *html.Node) (bool, error) {
+	exprs := []string{
+		`//*[contains(@data-hook, 'vine-review-badge')]`,
+		`//span[contains(text(), 'Vine Customer Review')]`,
+	}
+
+	for _, expr := range exprs {
+		if _, err := utils.FindNodes(node, expr, true); err == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ParseEdited parses whether the review has been edited from the edited marker of
+// the date line of the given HTML node.
+func (p *USReviewParser) ParseEdited(node *html.Node) (bool, error) {
+	expr := `//*[@data-hook='review-date']//text()[contains(., 'Edited')]`
+	if _, err := utils.FindNodes(node, expr, true); err == nil {
+		return true, nil
+	}
+	return false, nil
+}
+
+// ParseReviewID parses the review id, e.g. "R1ABC2DEF3GHI4", from the given HTML node.
+func (p *USReviewParser) ParseReviewID(node *html.Node) (string, error) {
+	return parseReviewID(node)
+}
+
+// ParsePermalink parses the absolute url of the review from the given HTML node.
+func (p *USReviewParser) ParsePermalink(node *html.Node) (string, error) {
+	return parsePermalink(node, usDomain)
 }
@@ -0,0 +1,19 @@
+package model
+
+// BoardItem is a ranked product of a best sellers or new releases board.
+type BoardItem struct {
+	ASIN   string `json:"asin"`
+	Rank   int    `json:"rank"`
+	Title  string `json:"title"`
+	Price  string `json:"price"`
+	Star   string `json:"star"`
+	Rating string `json:"rating"`
+}
+
+// BoardRec is an entry of the data-client-recs-list attribute of a board
+// page, which lists every ASIN of the board including the lazy loaded ones.
+type BoardRec struct {
+	ASIN     string            `json:"asin"`
+	Rank     int               `json:"rank"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
@@ -0,0 +1,33 @@
+package model
+
+// Money is a monetary amount together with its ISO 4217 currency code.
+type Money struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+// Coupon is a clippable coupon offered on a product or search result.
+// Exactly one of Percent and Amount is set.
+type Coupon struct {
+	Percent float64 `json:"percent,omitempty"`
+	Amount  *Money  `json:"amount,omitempty"`
+	Text    string  `json:"text"`
+}
+
+// LightningDeal describes a time boxed deal with a limited number of claims.
+type LightningDeal struct {
+	ClaimedPercent int    `json:"claimed_percent"`
+	EndsIn         string `json:"ends_in,omitempty"`
+}
+
+// PriceInfo groups the current price with the list price, deal and
+// coupon information found in the buy box of a product page.
+type PriceInfo struct {
+	Price              *Money         `json:"price,omitempty"`
+	ListPrice          *Money         `json:"list_price,omitempty"`
+	SavingsPercent     int            `json:"savings_percent,omitempty"`
+	DealBadge          string         `json:"deal_badge,omitempty"`
+	LightningDeal      *LightningDeal `json:"lightning_deal,omitempty"`
+	SubscribeSavePrice *Money         `json:"subscribe_save_price,omitempty"`
+	Coupon             *Coupon        `json:"coupon,omitempty"`
+}
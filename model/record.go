@@ -0,0 +1,86 @@
+package model
+
+// SchemaVersion is the version of the output contract of the records.
+// Fields may be added within a version; renaming or removing a field
+// requires a new version.
+const SchemaVersion = "v1"
+
+// ProductRecord is the record extracted from a product detail page.
+type ProductRecord struct {
+	ASIN              string            `json:"asin"`
+	Title             string            `json:"title"`
+	Brand             string            `json:"brand"`
+	Price             string            `json:"price"`
+	PrimePrice        string            `json:"prime_price,omitempty"`
+	Star              string            `json:"star"`
+	Rating            string            `json:"rating"`
+	Img               string            `json:"img"`
+	SoldBy            string            `json:"sold_by"`
+	DispatchFrom      string            `json:"dispatch_from"`
+	SellerID          string            `json:"seller_id"`
+	CategoryID        string            `json:"category_id"`
+	HasCart           bool              `json:"has_cart"`
+	Coupon            string            `json:"coupon,omitempty"`
+	Color             string            `json:"color,omitempty"`
+	Size              string            `json:"size,omitempty"`
+	Description       string            `json:"description"`
+	DeliveryTime      string            `json:"delivery_time"`
+	FastestDelivery   string            `json:"fastest_delivery,omitempty"`
+	ProductDimensions string            `json:"product_dimensions"`
+	PackageDimensions string            `json:"package_dimensions"`
+	ProductWeight     string            `json:"product_weight"`
+	PackageWeight     string            `json:"package_weight"`
+	FirstAvailDate    string            `json:"first_avail_date"`
+	Specs             []string          `json:"specs"`
+	CategoryHierarchy []string          `json:"category_hierarchy"`
+	CustomerReviews   map[string]string `json:"customer_reviews"`
+	PriceInfo         *PriceInfo        `json:"price_info"`
+	RatingHistogram   *RatingHistogram  `json:"rating_histogram"`
+	ReviewInsights    *ReviewInsights   `json:"review_insights,omitempty"`
+	LocalReviews      []*Review         `json:"local_reviews,omitempty"`
+	ForeignReviews    []*Review         `json:"foreign_reviews,omitempty"`
+}
+
+// ListingItem is a product card of a search, category, seller or board page.
+//
+// Position counts every product card of the result set starting at 1 on
+// the first page, while OrganicPosition and SponsoredPosition only count
+// organic and sponsored cards, so positions on page 2 continue those of
+// page 1. Rank is the organic position, or the best seller rank on board
+// pages, and is 0 for sponsored cards.
+type ListingItem struct {
+	ASIN              string  `json:"asin"`
+	Title             string  `json:"title"`
+	Price             string  `json:"price"`
+	ListPrice         *Money  `json:"list_price,omitempty"`
+	Star              string  `json:"star"`
+	Rating            string  `json:"rating,omitempty"`
+	Img               string  `json:"img,omitempty"`
+	Rank              int     `json:"rank,omitempty"`
+	Sponsored         bool    `json:"sponsored"`
+	Position          int     `json:"position,omitempty"`
+	OrganicPosition   int     `json:"organic_position,omitempty"`
+	SponsoredPosition int     `json:"sponsored_position,omitempty"`
+	Prime             bool    `json:"prime"`
+	Sales             string  `json:"sales,omitempty"`
+	DealBadge         string  `json:"deal_badge,omitempty"`
+	Coupon            *Coupon `json:"coupon,omitempty"`
+}
+
+// ListingRecord is the record extracted from a search, category, seller
+// or board page.
+type ListingRecord struct {
+	Keyword     string             `json:"keyword,omitempty"`
+	Category    string             `json:"category,omitempty"`
+	CurrentPage string             `json:"current_page,omitempty"`
+	MaxPage     string             `json:"max_page,omitempty"`
+	NextPageURL string             `json:"next_page_url,omitempty"`
+	ResultCount *ResultCount       `json:"result_count,omitempty"`
+	Refinements []*RefinementGroup `json:"refinements,omitempty"`
+	Items       []*ListingItem     `json:"items"`
+}
+
+// ReviewRecord is the record extracted from a customer reviews page.
+type ReviewRecord struct {
+	Reviews []*Review `json:"reviews"`
+}
@@ -0,0 +1,20 @@
+package model
+
+// RefinementGroup is a facet of the left navigation of a search or
+// category page, such as brand, price or customer review.
+type RefinementGroup struct {
+	ID      string              `json:"id"`
+	Name    string              `json:"name"`
+	Options []*RefinementOption `json:"options"`
+}
+
+// RefinementOption is a single selectable value of a refinement group.
+// Count is -1 when the page does not show the number of results.
+type RefinementOption struct {
+	ID       string `json:"id,omitempty"`
+	Label    string `json:"label"`
+	Count    int    `json:"count"`
+	Selected bool   `json:"selected"`
+	URL      string `json:"url,omitempty"`
+	Filter   string `json:"filter,omitempty"`
+}
@@ -0,0 +1,60 @@
+package model
+
+// RatingHistogram is the customer review summary of a product page.
+type RatingHistogram struct {
+	// Percentages holds the share of ratings per star, Percentages[0] being
+	// the share of 1 star ratings and Percentages[4] of 5 star ratings.
+	Percentages  [5]float64 `json:"percentages"`
+	TotalRatings int        `json:"total_ratings"`
+	Average      float64    `json:"average"`
+}
+
+// Percent returns the share of ratings with the given number of stars.
+func (h *RatingHistogram) Percent(star int) float64 {
+	if star < 1 || star > 5 {
+		return 0
+	}
+	return h.Percentages[star-1]
+}
+
+// Sentiments of a review aspect.
+const (
+	SentimentPositive = "positive"
+	SentimentNegative = "negative"
+	SentimentMixed    = "mixed"
+)
+
+// ReviewInsights is the AI generated "Customers say" block of a product page.
+type ReviewInsights struct {
+	Summary string          `json:"summary"`
+	Aspects []*ReviewAspect `json:"aspects,omitempty"`
+}
+
+// ReviewAspect is an aspect chip such as "Quality" or "Value for money".
+// The mention counts are 0 when the page does not show them.
+type ReviewAspect struct {
+	Name      string `json:"name"`
+	Sentiment string `json:"sentiment"`
+	Mentions  int    `json:"mentions,omitempty"`
+	Positive  int    `json:"positive,omitempty"`
+	Negative  int    `json:"negative,omitempty"`
+}
+
+// Review is a customer review.
+type Review struct {
+	ID           string   `json:"id,omitempty"`
+	Permalink    string   `json:"permalink,omitempty"`
+	Reviewer     string   `json:"reviewer"`
+	ReviewerLink string   `json:"reviewer_link,omitempty"`
+	Star         float64  `json:"star"`
+	Title        string   `json:"title"`
+	Date         string   `json:"date"`
+	Verified     bool     `json:"verified"`
+	Content      string   `json:"content"`
+	HelpfulVotes int      `json:"helpful_votes"`
+	Images       []string `json:"images,omitempty"`
+	Videos       []string `json:"videos,omitempty"`
+	Variant      string   `json:"variant,omitempty"`
+	Vine         bool     `json:"vine"`
+	Edited       bool     `json:"edited"`
+}
@@ -0,0 +1,73 @@
+package model
+
+// PlacementType classifies a slot on a search result page.
+type PlacementType string
+
+const (
+	PlacementOrganic          PlacementType = "organic"
+	PlacementSponsoredProduct PlacementType = "sponsored_product"
+	PlacementSponsoredBrand   PlacementType = "sponsored_brand"
+	PlacementSponsoredVideo   PlacementType = "sponsored_video"
+	PlacementEditorial        PlacementType = "editorial"
+)
+
+// Badges shown on search result cards.
+const (
+	BadgeAmazonsChoice = "amazons_choice"
+	BadgeBestSeller    = "best_seller"
+	BadgeHighlyRated   = "highly_rated"
+)
+
+// Placement is a single ASIN shown on a search result page together with
+// the kind of slot it was shown in and its position on the page.
+//
+// Position counts every ASIN in page order starting at 1. OrganicPosition
+// and SponsoredPosition only count organic and sponsored ASINs respectively
+// and are 0 when the ASIN does not belong to that group.
+type Placement struct {
+	ASIN              string        `json:"asin"`
+	Type              PlacementType `json:"type"`
+	Badges            []string      `json:"badges,omitempty"`
+	Position          int           `json:"position"`
+	OrganicPosition   int           `json:"organic_position,omitempty"`
+	SponsoredPosition int           `json:"sponsored_position,omitempty"`
+}
+
+// IsSponsored reports whether the placement is a paid ad.
+func (p *Placement) IsSponsored() bool {
+	switch p.Type {
+	case PlacementSponsoredProduct, PlacementSponsoredBrand, PlacementSponsoredVideo:
+		return true
+	default:
+		return false
+	}
+}
+
+// SearchResult is a product card of a search result page with its rank.
+// Ranked products are returned in the order of ParseAllProducts, so the
+// i-th result belongs to the i-th product card.
+//
+// Position counts every product card of the result set starting at 1 on
+// the first page, while OrganicPosition and SponsoredPosition only count
+// organic and sponsored cards respectively and are 0 when the card does
+// not belong to that group. Positions on later pages continue those of the
+// previous pages.
+type SearchResult struct {
+	ASIN              string `json:"asin"`
+	Index             int    `json:"index"`
+	Page              int    `json:"page"`
+	Sponsored         bool   `json:"sponsored"`
+	Position          int    `json:"position"`
+	OrganicPosition   int    `json:"organic_position,omitempty"`
+	SponsoredPosition int    `json:"sponsored_position,omitempty"`
+}
+
+// ResultCount is the "1-48 of over 10,000 results for" line of a search,
+// category or seller page.
+type ResultCount struct {
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Total int    `json:"total"`
+	Over  bool   `json:"over"`
+	Query string `json:"query,omitempty"`
+}
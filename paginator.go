@@ -0,0 +1,269 @@
+package goamzparser
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+
+	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+// trackingParams are query parameters that do not change the result set.
+var trackingParams = []string{
+	"ref", "ref_", "qid", "sprefix", "crid", "sr", "dib", "dib_tag", "_encoding",
+	"content-id", "pd_rd_i", "pd_rd_r", "pd_rd_w", "pd_rd_wg", "pf_rd_i", "pf_rd_m",
+	"pf_rd_p", "pf_rd_r", "pf_rd_s", "pf_rd_t", "xpid",
+}
+
+// Paginator builds absolute, canonical page URLs of a keyword, category,
+// seller or board page.
+type Paginator struct {
+	prefix    string
+	pageParam string
+	current   int
+	max       int
+	next      *url.URL
+	prev      *url.URL
+}
+
+// nextPageParser is implemented by the parsers of paginated pages.
+type nextPageParser interface {
+	ParseNextPageURL(doc *html.Node) (string, error)
+}
+
+// maxPageParser is implemented by the parsers of pages showing the last
+// page number.
+type maxPageParser interface {
+	ParseMaxPageNum(doc *html.Node) (string, error)
+}
+
+// pageParser returns the registered parser of the given paginated page type.
+func (p *Parser) pageParser(region, pageType string) (nextPageParser, error) {
+	var parser nextPageParser
+	switch pageType {
+	case PageSearch:
+		if keywordParser := p.GetKeywordParser(region); keywordParser != nil {
+			parser = keywordParser
+		}
+	case PageCategory:
+		if categoryParser := p.GetCategoryParser(region); categoryParser != nil {
+			parser = categoryParser
+		}
+	case PageSeller:
+		if sellerParser := p.GetSellerParser(region); sellerParser != nil {
+			parser = sellerParser
+		}
+	case PageBoard:
+		if boardParser := p.GetBoardParser(region); boardParser != nil {
+			parser = boardParser
+		}
+	default:
+		return nil, fmt.Errorf("'%v' error, page type is not paginated", pageType)
+	}
+	if parser == nil {
+		return nil, fmt.Errorf("'%v' error, unsupported region", region)
+	}
+	return parser, nil
+}
+
+// NewPaginator creates a paginator from the pagination bar of the given
+// HTML document of the given page type. The region selects the parser and
+// the domain of the generated URLs.
+func (p *Parser) NewPaginator(doc *html.Node, region, pageType string) (*Paginator, error) {
+	parser, err := p.pageParser(region, pageType)
+	if err != nil {
+		return nil, err
+	}
+	return newPaginator(doc, region, parser)
+}
+
+// newPaginator creates a paginator using the given page parser first and
+// the generic pagination selectors as fallback.
+func newPaginator(doc *html.Node, region string, parser nextPageParser) (*Paginator, error) {
+	prefix := RegionPrefix(region)
+	if prefix == "" {
+		return nil, fmt.Errorf("'%v' error, unsupported region", region)
+	}
+
+	p := &Paginator{prefix: prefix, pageParam: "page", current: 1}
+
+	if next, err := parser.ParseNextPageURL(doc); err == nil {
+		p.next = p.canonical(next)
+	}
+	if p.next == nil {
+		p.next = p.findURL(doc, []string{
+			`//a[contains(@class, 's-pagination-next') and @href]`,
+			`//ul[contains(@class, 'a-pagination')]/li[contains(@class, 'a-last')]/a[@href]`,
+		})
+	}
+
+	p.prev = p.findURL(doc, []string{
+		`//a[contains(@class, 's-pagination-previous') and @href]`,
+	})
+
+	for _, u := range []*url.URL{p.next, p.prev} {
+		if u != nil && u.Query().Has("pg") {
+			p.pageParam = "pg"
+		}
+	}
+
+	if current, ok := findNumber(doc, []string{
+		`//span[contains(@class, 's-pagination-selected')]/text()`,
+		`//ul[contains(@class, 'a-pagination')]/li[contains(@class, 'a-selected')]/a/text()`,
+	}, false); ok {
+		p.current = current
+	}
+
+	p.max = p.current
+	if parser, ok := parser.(maxPageParser); ok {
+		if max, err := parser.ParseMaxPageNum(doc); err == nil {
+			if n, err := strconv.Atoi(max); err == nil && n > p.max {
+				p.max = n
+			}
+		}
+	}
+	if max, ok := findNumber(doc, []string{
+		`//ul[contains(@class, 'a-pagination')]/li[contains(@class, 'a-normal') or contains(@class, 'a-selected')]/a/text()`,
+		`//*[contains(@class, 's-pagination-item') and not(contains(@class, 's-pagination-next')) and not(contains(@class, 's-pagination-previous'))]/text()`,
+	}, true); ok && max > p.max {
+		p.max = max
+	}
+	if p.next != nil && p.max <= p.current {
+		p.max = p.current + 1
+	}
+	return p, nil
+}
+
+// CurrentPage returns the index of the current page starting at 1.
+func (p *Paginator) CurrentPage() int {
+	return p.current
+}
+
+// MaxPage returns the index of the last page.
+func (p *Paginator) MaxPage() int {
+	return p.max
+}
+
+// NextPageURL returns the absolute URL of the next page.
+func (p *Paginator) NextPageURL() (string, error) {
+	if p.next == nil {
+		return "unknown", errors.ErrorNotFoundNextPage
+	}
+	return p.next.String(), nil
+}
+
+// PrevPageURL returns the absolute URL of the previous page.
+func (p *Paginator) PrevPageURL() (string, error) {
+	if p.current <= 1 {
+		return "unknown", errors.ErrorNotFoundPrevPage
+	}
+	if p.prev != nil {
+		return p.prev.String(), nil
+	}
+	return p.PageURL(p.current - 1)
+}
+
+// PageURL returns the absolute URL of page n.
+func (p *Paginator) PageURL(n int) (string, error) {
+	if n < 1 || n > p.max {
+		return "unknown", fmt.Errorf("'%v' error, page out of range 1-%v", n, p.max)
+	}
+
+	base := p.next
+	if base == nil {
+		base = p.prev
+	}
+	if base == nil {
+		return "unknown", errors.ErrorNotFoundNextPage
+	}
+
+	u := *base
+	values := u.Query()
+	if n == 1 && p.pageParam == "page" {
+		values.Del(p.pageParam)
+	} else {
+		values.Set(p.pageParam, strconv.Itoa(n))
+	}
+	u.RawQuery = values.Encode()
+	return u.String(), nil
+}
+
+// PageURLs returns the absolute URLs of all pages from 1 to MaxPage.
+func (p *Paginator) PageURLs() ([]string, error) {
+	urls := make([]string, 0, p.max)
+	for n := 1; n <= p.max; n++ {
+		u, err := p.PageURL(n)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, u)
+	}
+	return urls, nil
+}
+
+// findURL returns the canonical URL of the first link matched by exprs.
+func (p *Paginator) findURL(doc *html.Node, exprs []string) *url.URL {
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(doc, expr, true)
+		if err == nil {
+			if u := p.canonical(htmlquery.SelectAttr(nodes[0], "href")); u != nil {
+				return u
+			}
+		}
+	}
+	return nil
+}
+
+// canonical resolves ref against the region prefix and removes the
+// tracking parameters and "/ref=" path segments.
+func (p *Paginator) canonical(ref string) *url.URL {
+	ref = strings.TrimSpace(html.UnescapeString(ref))
+	if ref == "" || ref == "unknown" {
+		return nil
+	}
+
+	base, err := url.Parse(p.prefix)
+	if err != nil {
+		return nil
+	}
+	u, err := base.Parse(ref)
+	if err != nil {
+		return nil
+	}
+
+	if i := strings.Index(u.Path, "/ref="); i >= 0 {
+		u.Path = u.Path[:i]
+		u.RawPath = ""
+	}
+
+	values := u.Query()
+	for _, param := range trackingParams {
+		values.Del(param)
+	}
+	u.RawQuery = values.Encode()
+	u.Fragment = ""
+	return u
+}
+
+// findNumber returns the first, or the last if last is set, number matched by exprs.
+func findNumber(doc *html.Node, exprs []string, last bool) (int, bool) {
+	for _, expr := range exprs {
+		nodes, err := utils.FindNodes(doc, expr, true)
+		if err != nil {
+			continue
+		}
+
+		if last {
+			nodes = nodes[len(nodes)-1:]
+		}
+		if n, err := strconv.Atoi(strings.TrimSpace(nodes[0].Data)); err == nil {
+			return n, true
+		}
+	}
+	return 0, false
+}
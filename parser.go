@@ -9,6 +9,7 @@ import (
 	"github.com/microsuite/go-amz-parser/internal/product"
 	"github.com/microsuite/go-amz-parser/internal/review"
 	"github.com/microsuite/go-amz-parser/internal/seller"
+	"github.com/microsuite/go-amz-parser/model"
 	"github.com/microsuite/go-amz-parser/utils"
 	"golang.org/x/net/html"
 )
@@ -94,6 +95,21 @@ type ProductParser interface {
 
 	// ParseCustomerReviews parses the customer reviews from the given HTML document.
 	ParseCustomerReviews(doc *html.Node) (map[string]string, error)
+
+	// ParsePriceInfo parses the price, list price, deal and coupon from the given HTML document.
+	ParsePriceInfo(doc *html.Node) (*model.PriceInfo, error)
+
+	// ParseRatingHistogram parses the star histogram, total ratings and average star from the given HTML document.
+	ParseRatingHistogram(doc *html.Node) (*model.RatingHistogram, error)
+
+	// ParseReviewInsights parses the "Customers say" summary and aspects from the given HTML document.
+	ParseReviewInsights(doc *html.Node) (*model.ReviewInsights, error)
+
+	// ParseLocalReviews parses the top reviews from the region of the given HTML document.
+	ParseLocalReviews(doc *html.Node) ([]*model.Review, error)
+
+	// ParseForeignReviews parses the top reviews from other countries of the given HTML document.
+	ParseForeignReviews(doc *html.Node) ([]*model.Review, error)
 }
 
 type KeywordParser interface {
@@ -135,6 +151,26 @@ type KeywordParser interface {
 
 	// ParseTitle parses the title from the given HTML node.
 	ParseTitle(node *html.Node) (string, error)
+
+	// ParseCoupon parses the coupon from the given HTML node.
+	ParseCoupon(node *html.Node) (*model.Coupon, error)
+
+	// ParseDealBadge parses the deal badge from the given HTML node.
+	ParseDealBadge(node *html.Node) (string, error)
+
+	// ParseListPrice parses the strike-through list price from the given HTML node.
+	ParseListPrice(node *html.Node) (*model.Money, error)
+
+	// ParsePlacements parses the organic and sponsored placements from the given HTML document.
+	ParsePlacements(doc *html.Node) ([]*model.Placement, error)
+
+	// ParseRankedProducts parses all products with their positions across the result set from the given HTML document.
+	ParseRankedProducts(doc *html.Node) ([]*model.SearchResult, error)
+
+	// ParseRefinements parses the refinement groups of the left navigation from the given HTML document.
+	ParseRefinements(doc *html.Node) ([]*model.RefinementGroup, error)
+	// ParseResultCount parses the result range, total count and query from the given HTML document.
+	ParseResultCount(doc *html.Node) (*model.ResultCount, error)
 }
 
 type CategoryParser interface {
@@ -176,6 +212,21 @@ type CategoryParser interface {
 
 	// ParseTitle parses the title from the given HTML node.
 	ParseTitle(node *html.Node) (string, error)
+
+	// ParseCoupon parses the coupon from the given HTML node.
+	ParseCoupon(node *html.Node) (*model.Coupon, error)
+
+	// ParseDealBadge parses the deal badge from the given HTML node.
+	ParseDealBadge(node *html.Node) (string, error)
+
+	// ParseListPrice parses the strike-through list price from the given HTML node.
+	ParseListPrice(node *html.Node) (*model.Money, error)
+
+	// ParseRefinements parses the refinement groups of the left navigation from the given HTML document.
+	ParseRefinements(doc *html.Node) ([]*model.RefinementGroup, error)
+
+	// ParseResultCount parses the result range, total count and query from the given HTML document.
+	ParseResultCount(doc *html.Node) (*model.ResultCount, error)
 }
 
 type SellerParser interface {
@@ -214,6 +265,18 @@ type SellerParser interface {
 
 	// ParseTitle parses the title from the given HTML node.
 	ParseTitle(node *html.Node) (string, error)
+
+	// ParseCoupon parses the coupon from the given HTML node.
+	ParseCoupon(node *html.Node) (*model.Coupon, error)
+
+	// ParseDealBadge parses the deal badge from the given HTML node.
+	ParseDealBadge(node *html.Node) (string, error)
+
+	// ParseListPrice parses the strike-through list price from the given HTML node.
+	ParseListPrice(node *html.Node) (*model.Money, error)
+
+	// ParseResultCount parses the result range, total count and query from the given HTML document.
+	ParseResultCount(doc *html.Node) (*model.ResultCount, error)
 }
 
 type BoardParser interface {
@@ -261,6 +324,9 @@ type BoardParser interface {
 
 	// ParseRank parses the rank from the give html node.
 	ParseRank(node *html.Node) (string, error)
+
+	// ParseRecsItems parses the ranked ASINs of the recs list from the given html document.
+	ParseRecsItems(doc *html.Node) ([]*model.BoardRec, error)
 }
 
 type AmzReviewParser interface {
@@ -287,6 +353,33 @@ type AmzReviewParser interface {
 
 	// ParseContent parses the content from the give html node.
 	ParseContent(node *html.Node) (string, error)
+
+	// ParseReview parses all fields of the review from the given HTML node.
+	ParseReview(node *html.Node) (*model.Review, error)
+
+	// ParseHelpfulVotes parses the number of people who found the review helpful from the given HTML node.
+	ParseHelpfulVotes(node *html.Node) (string, error)
+
+	// ParseImages parses the customer image urls from the given HTML node.
+	ParseImages(node *html.Node) ([]string, error)
+
+	// ParseVideos parses the customer video urls from the given HTML node.
+	ParseVideos(node *html.Node) ([]string, error)
+
+	// ParseVariant parses the reviewed variant from the given HTML node.
+	ParseVariant(node *html.Node) (string, error)
+
+	// ParseVine parses whether the review is a Vine customer review from the given HTML node.
+	ParseVine(node *html.Node) (bool, error)
+
+	// ParseEdited parses whether the review has been edited from the given HTML node.
+	ParseEdited(node *html.Node) (bool, error)
+
+	// ParseReviewID parses the review id from the given HTML node.
+	ParseReviewID(node *html.Node) (string, error)
+
+	// ParsePermalink parses the absolute url of the review from the given HTML node.
+	ParsePermalink(node *html.Node) (string, error)
 }
 
 type Parser struct {
@@ -1,684 +1,1248 @@
 package goamzparser
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/antchfx/htmlquery"
+
+	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
 )
 
-func TestCategoryParser(t *testing.T) {
+var update = flag.Bool("update", false, "update the golden files of testdata")
+
+func TestParsePriceInfo(t *testing.T) {
 	p := NewParser()
 
-	doc, err := htmlquery.LoadDoc("./input.html")
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="de-de"><body>
+		<div id="corePriceDisplay_desktop_feature_div">
+			<span class="a-price priceToPay" data-a-color="price"><span class="a-offscreen">1.299,99€</span></span>
+			<span class="a-size-large savingsPercentage">-19%</span>
+			<span class="basisPrice">UVP: <span class="a-price a-text-price" data-a-strike="true"><span class="a-offscreen">1.599,00€</span></span></span>
+		</div>
+		<div id="dealBadge_feature_div"><span>Blitzangebot</span></div>
+		<span>45% beansprucht</span>
+		<span>Endet in 02:13:45</span>
+		<i>Coupon</i><label>10% Coupon anwenden</label>
+	</body></html>`))
 	if err != nil {
 		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	region, err := ParseRegion(doc)
+	info, err := p.GetProductParser(DE).ParsePriceInfo(doc)
 	if err != nil {
-		t.Fatalf("Error parsing region: %s\n", err.Error())
+		t.Fatalf("Error parsing price info: %s\n", err.Error())
 	}
 
-	parser := p.GetCategoryParser(region)
-	if parser == nil {
-		t.Fatal("No parser found for region: " + region)
+	if info.Price == nil || info.Price.Amount != 1299.99 || info.Price.Currency != "EUR" {
+		t.Errorf("Unexpected price: %+v\n", info.Price)
+	}
+	if info.ListPrice == nil || info.ListPrice.Amount != 1599 {
+		t.Errorf("Unexpected list price: %+v\n", info.ListPrice)
+	}
+	if info.SavingsPercent != 19 {
+		t.Errorf("Unexpected savings percent: %v\n", info.SavingsPercent)
+	}
+	if info.LightningDeal == nil || info.LightningDeal.ClaimedPercent != 45 || info.LightningDeal.EndsIn != "02:13:45" {
+		t.Errorf("Unexpected lightning deal: %+v\n", info.LightningDeal)
+	}
+	if info.Coupon == nil || info.Coupon.Percent != 10 {
+		t.Errorf("Unexpected coupon: %+v\n", info.Coupon)
 	}
 
-	pageNum, err := parser.ParseMaxPageNum(doc)
-	if err != nil {
-		t.Errorf("Error parsing max page num: %s\n", err.Error())
-	} else {
-		fmt.Printf("Max page num: %s\n", pageNum)
+	// The strike-through list price is a sibling of its label.
+	tests := []struct {
+		region string
+		label  string
+		price  string
+		amount float64
+	}{
+		{US, "List Price:", "$29.99", 29.99},
+		{UK, "RRP:", "£24.99", 24.99},
+		{DE, "UVP:", "24,99 €", 24.99},
+		{FR, "Prix conseillé :", "24,99 €", 24.99},
 	}
+	for _, test := range tests {
+		doc, err := htmlquery.Parse(strings.NewReader(fmt.Sprintf(`<html><body>
+			<div class="a-section"><span class="a-size-small a-color-secondary">%v</span>
+				<span class="a-price a-text-price" data-a-strike="true"><span class="a-offscreen">%v</span></span></div>
+		</body></html>`, test.label, test.price)))
+		if err != nil {
+			t.Fatalf("Error loading document: %s\n", err.Error())
+		}
 
-	nextPage, err := parser.ParseNextPageURL(doc)
-	if err != nil {
-		t.Errorf("Error parsing next page: %s\n", err.Error())
-	} else {
-		fmt.Printf("Next page url: %s\n", nextPage)
+		info, err := p.GetProductParser(test.region).ParsePriceInfo(doc)
+		if err != nil {
+			t.Fatalf("Error parsing %v price info: %s\n", test.region, err.Error())
+		}
+		if info.ListPrice == nil || info.ListPrice.Amount != test.amount {
+			t.Errorf("Unexpected %v list price: %+v\n", test.region, info.ListPrice)
+		}
 	}
+}
+
+func TestKeywordParserPromotions(t *testing.T) {
+	p := NewParser()
 
-	contentId, err := parser.ParseContentId(doc)
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="en-us"><body>
+		<div class="s-result-item" data-asin="B0ABCDEFGH" data-index="1" data-uuid="u1">
+			<span class="a-badge-text" data-a-badge-color="sx-lightning-deal-red">Limited time deal</span>
+			<span class="a-price" data-a-color="base"><span class="a-offscreen">$19.99</span></span>
+			<span class="a-price a-text-price" data-a-strike="true"><span class="a-offscreen">$24.99</span></span>
+			<span class="s-coupon-unclipped"><span class="s-coupon-highlight-color">Save $5.00</span> <span>with coupon</span></span>
+		</div>
+	</body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing content id: %s\n", err.Error())
-	} else {
-		fmt.Printf("Content id: %s\n", contentId)
+		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	contentLink, err := parser.ParseContentLink(doc)
+	parser := p.GetKeywordParser(US)
+	nodes, err := parser.ParseAllProducts(doc)
 	if err != nil {
-		t.Errorf("Error parsing content link: %s\n", err.Error())
-	} else {
-		fmt.Printf("Content link: %s\n", contentLink)
+		t.Fatalf("Error parsing products: %s\n", err.Error())
 	}
 
-	pagination, err := parser.ParsePagination(doc)
+	coupon, err := parser.ParseCoupon(nodes[0])
 	if err != nil {
-		t.Errorf("Error parsing pagination: %s\n", err.Error())
-	} else {
-		fmt.Printf("Pagination: %s\n", pagination)
+		t.Errorf("Error parsing coupon: %s\n", err.Error())
+	} else if coupon.Amount == nil || coupon.Amount.Amount != 5 {
+		t.Errorf("Unexpected coupon: %+v\n", coupon)
 	}
 
-	nodes, err := parser.ParseAllProducts(doc)
-	if err != nil {
-		t.Fatalf("Error parsing products: %s\n", err.Error())
+	badge, err := parser.ParseDealBadge(nodes[0])
+	if err != nil || badge != "Limited time deal" {
+		t.Errorf("Unexpected deal badge: %v, %v\n", badge, err)
 	}
 
-	for _, node := range nodes {
-		asin, err := parser.ParseASIN(node)
-		if err != nil {
-			t.Errorf("Error parsing asin: %s\n", err.Error())
-		} else {
-			fmt.Printf("ASIN: %s\n", asin)
-		}
+	listPrice, err := parser.ParseListPrice(nodes[0])
+	if err != nil || listPrice.Amount != 24.99 {
+		t.Errorf("Unexpected list price: %+v, %v\n", listPrice, err)
+	}
+}
 
-		price, err := parser.ParsePrice(node)
-		if err != nil {
-			t.Errorf("Error parsing price: %s\n", err.Error())
-		} else {
-			fmt.Printf("Price: %v\n", price)
-		}
+func TestKeywordParserPlacements(t *testing.T) {
+	p := NewParser()
 
-		star, err := parser.ParseStar(node)
-		if err != nil {
-			t.Errorf("Error parsing star: %s\n", err.Error())
-		} else {
-			fmt.Printf("Star: %v\n", star)
-		}
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="de-de"><body>
+		<div class="s-result-item s-widget AdHolder" data-index="0" data-cel-widget="MAIN-SB_DESKTOP-1">
+			<div data-asin="B0BRAND001"></div><div data-asin="B0BRAND002"></div>
+		</div>
+		<div class="s-result-item AdHolder" data-index="1" data-asin="B0SPONS001" data-component-type="s-search-result">
+			<span class="puis-label-popover-default"><span>Gesponsert</span></span>
+		</div>
+		<div class="s-result-item" data-index="2" data-asin="B0ORGAN001" data-component-type="s-search-result">
+			<span id="B0ORGAN001-amazons-choice">Amazons Tipp</span>
+		</div>
+		<div class="s-result-item" data-index="3" data-asin="B0ORGAN002" data-component-type="s-search-result">
+			<span id="B0ORGAN002-best-seller">Bestseller</span>
+		</div>
+	</body></html>`))
+	if err != nil {
+		t.Fatalf("Error loading document: %s\n", err.Error())
+	}
 
-		title, err := parser.ParseTitle(node)
-		if err != nil {
-			t.Errorf("Error parsing title: %s\n", err.Error())
-		} else {
-			fmt.Printf("title: %v\n", title)
+	placements, err := p.GetKeywordParser(DE).ParsePlacements(doc)
+	if err != nil {
+		t.Fatalf("Error parsing placements: %s\n", err.Error())
+	}
+
+	expected := []struct {
+		asin      string
+		typ       model.PlacementType
+		organic   int
+		sponsored int
+	}{
+		{"B0BRAND001", model.PlacementSponsoredBrand, 0, 1},
+		{"B0BRAND002", model.PlacementSponsoredBrand, 0, 2},
+		{"B0SPONS001", model.PlacementSponsoredProduct, 0, 3},
+		{"B0ORGAN001", model.PlacementOrganic, 1, 0},
+		{"B0ORGAN002", model.PlacementOrganic, 2, 0},
+	}
+	if len(placements) != len(expected) {
+		t.Fatalf("Unexpected placement count: %v\n", len(placements))
+	}
+	for i, e := range expected {
+		got := placements[i]
+		if got.ASIN != e.asin || got.Type != e.typ || got.Position != i+1 ||
+			got.OrganicPosition != e.organic || got.SponsoredPosition != e.sponsored {
+			t.Errorf("Unexpected placement %v: %+v\n", i, got)
 		}
 	}
+
+	if len(placements[3].Badges) != 1 || placements[3].Badges[0] != model.BadgeAmazonsChoice {
+		t.Errorf("Unexpected badges: %v\n", placements[3].Badges)
+	}
+	if len(placements[4].Badges) != 1 || placements[4].Badges[0] != model.BadgeBestSeller {
+		t.Errorf("Unexpected badges: %v\n", placements[4].Badges)
+	}
 }
 
-func TestSellerParser(t *testing.T) {
+func TestKeywordParserRankedProducts(t *testing.T) {
 	p := NewParser()
 
-	doc, err := htmlquery.LoadDoc("./input.html")
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="en-us"><body>
+		<div class="s-result-item AdHolder" data-asin="B0SPONS001" data-index="2" data-uuid="u1"></div>
+		<div class="s-result-item" data-asin="B0ORGAN001" data-index="3" data-uuid="u2"></div>
+		<div class="s-result-item" data-asin="B0ORGAN002" data-index="4" data-uuid="u3"></div>
+		<span class="s-pagination-item s-pagination-selected" aria-label="Current page, page 2">2</span>
+	</body></html>`))
 	if err != nil {
 		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	region, err := ParseRegion(doc)
+	results, err := p.GetKeywordParser(US).ParseRankedProducts(doc)
 	if err != nil {
-		t.Fatalf("Error parsing region: %s\n", err.Error())
+		t.Fatalf("Error parsing ranked products: %s\n", err.Error())
+	}
+	if len(results) != 3 {
+		t.Fatalf("Unexpected result count: %v\n", len(results))
 	}
 
-	parser := p.GetSellerParser(region)
-	if parser == nil {
-		t.Fatal("No parser found for region: " + region)
+	last := results[2]
+	if last.ASIN != "B0ORGAN002" || last.Page != 2 || last.Index != 4 || last.Position != 6 ||
+		last.OrganicPosition != 4 || last.SponsoredPosition != 0 || last.Sponsored {
+		t.Errorf("Unexpected result: %+v\n", last)
+	}
+	if !results[0].Sponsored || results[0].Position != 4 || results[0].SponsoredPosition != 2 {
+		t.Errorf("Unexpected result: %+v\n", results[0])
 	}
 
-	pageNum, err := parser.ParseMaxPageNum(doc)
+	doc, err = htmlquery.Parse(strings.NewReader(`<html lang="en-us"><body>
+		<span data-component-type="s-result-info-bar"><h2><span>49-50 of over 1,000 results for</span></h2></span>
+		<div class="s-result-item" data-asin="B0ORGAN049" data-index="1" data-uuid="u1"></div>
+		<div class="s-result-item" data-asin="B0ORGAN050" data-index="2" data-uuid="u2"></div>
+		<span class="s-pagination-item s-pagination-selected" aria-label="Current page, page 2">2</span>
+	</body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing max page num: %s\n", err.Error())
-	} else {
-		fmt.Printf("Max page num: %s\n", pageNum)
+		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	nextPage, err := parser.ParseNextPageURL(doc)
-	if err != nil {
-		t.Errorf("Error parsing next page: %s\n", err.Error())
-	} else {
-		fmt.Printf("Next page url: %s\n", nextPage)
+	results, err = p.GetKeywordParser(US).ParseRankedProducts(doc)
+	if err != nil || len(results) != 2 {
+		t.Fatalf("Error parsing ranked products: %v\n", err)
+	}
+	if results[1].OrganicPosition != 50 || results[1].Position != 4 {
+		t.Errorf("Unexpected result: %+v\n", results[1])
 	}
+}
+
+func TestCategoryParserRefinements(t *testing.T) {
+	p := NewParser()
 
-	contentId, err := parser.ParseContentId(doc)
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="en-us"><body>
+		<div id="s-refinements">
+			<div id="brandsRefinements">
+				<div><span class="a-size-base a-color-base a-text-bold">Brands</span></div>
+				<ul>
+					<li id="p_89/Apple"><span class="a-list-item">
+						<a href="/s?k=phone&amp;rh=n%3A2335752011%2Cp_89%3AApple&amp;ref=sr_nr_p_89_1">
+							<div class="a-checkbox"><label><input type="checkbox" checked=""/></label></div>
+							<span class="a-size-base a-color-base">Apple</span>
+						</a>
+					</span></li>
+					<li id="p_89/Samsung"><span class="a-list-item">
+						<a href="/s?k=phone&amp;rh=n%3A2335752011%2Cp_89%3ASamsung">
+							<span class="a-size-base a-color-base">Samsung</span>
+							<span class="a-size-base a-color-secondary">(1,204)</span>
+						</a>
+					</span></li>
+				</ul>
+			</div>
+		</div>
+	</body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing content id: %s\n", err.Error())
-	} else {
-		fmt.Printf("Content id: %s\n", contentId)
+		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	contentLink, err := parser.ParseContentLink(doc)
+	groups, err := p.GetCategoryParser(US).ParseRefinements(doc)
 	if err != nil {
-		t.Errorf("Error parsing content link: %s\n", err.Error())
-	} else {
-		fmt.Printf("Content link: %s\n", contentLink)
+		t.Fatalf("Error parsing refinements: %s\n", err.Error())
+	}
+	if len(groups) != 1 || groups[0].Name != "Brands" || len(groups[0].Options) != 2 {
+		t.Fatalf("Unexpected refinements: %+v\n", groups)
 	}
 
-	pagination, err := parser.ParsePagination(doc)
-	if err != nil {
-		t.Errorf("Error parsing pagination: %s\n", err.Error())
-	} else {
-		fmt.Printf("pagination: %s\n", pagination)
+	apple, samsung := groups[0].Options[0], groups[0].Options[1]
+	if apple.Label != "Apple" || !apple.Selected || apple.Count != -1 || apple.Filter != "n:2335752011,p_89:Apple" {
+		t.Errorf("Unexpected option: %+v\n", apple)
 	}
+	if samsung.Label != "Samsung" || samsung.Selected || samsung.Count != 1204 || samsung.ID != "p_89/Samsung" {
+		t.Errorf("Unexpected option: %+v\n", samsung)
+	}
+}
 
-	nodes, err := parser.ParseAllProducts(doc)
-	if err != nil {
-		t.Fatalf("Error parsing products: %s\n", err.Error())
+func TestKeywordParserResultCount(t *testing.T) {
+	p := NewParser()
+
+	tests := []struct {
+		region string
+		html   string
+		want   model.ResultCount
+	}{
+		{US, `<span>1-48 of over 3,000 results for</span><span class="a-color-state">"usb c cable"</span>`, model.ResultCount{Start: 1, End: 48, Total: 3000, Over: true, Query: "usb c cable"}},
+		{UK, `<span>49-96 of 512 results for</span><span>"hdmi"</span>`, model.ResultCount{Start: 49, End: 96, Total: 512, Query: "hdmi"}},
+		{DE, `<span>1-48 von mehr als 3.000 Ergebnissen oder Vorschlägen für</span><span>"usb c kabel"</span>`, model.ResultCount{Start: 1, End: 48, Total: 3000, Over: true, Query: "usb c kabel"}},
+		{FR, `<span>1-48 sur plus de 3 000 résultats pour</span><span>"câble usb c"</span>`, model.ResultCount{Start: 1, End: 48, Total: 3000, Over: true, Query: "câble usb c"}},
 	}
 
-	for _, node := range nodes {
-		asin, err := parser.ParseASIN(node)
+	for _, test := range tests {
+		doc, err := htmlquery.Parse(strings.NewReader(`<html><body>` + test.html + `</body></html>`))
 		if err != nil {
-			t.Errorf("Error parsing asin: %s\n", err.Error())
-		} else {
-			fmt.Printf("ASIN: %s\n", asin)
+			t.Fatalf("Error loading document: %s\n", err.Error())
 		}
 
-		price, err := parser.ParsePrice(node)
+		count, err := p.GetKeywordParser(test.region).ParseResultCount(doc)
 		if err != nil {
-			t.Errorf("Error parsing price: %s\n", err.Error())
-		} else {
-			fmt.Printf("Price: %v\n", price)
+			t.Errorf("Error parsing result count for %v: %s\n", test.region, err.Error())
+		} else if *count != test.want {
+			t.Errorf("Unexpected result count for %v: %+v\n", test.region, count)
 		}
+	}
+
+	doc, err := htmlquery.Parse(strings.NewReader(`<html><body><span>no count</span></body></html>`))
+	if err != nil {
+		t.Fatalf("Error loading document: %s\n", err.Error())
+	}
+	if _, err := p.GetKeywordParser(US).ParseResultCount(doc); err == nil {
+		t.Errorf("Expected an error for a page without result count\n")
+	}
+}
 
-		star, err := parser.ParseStar(node)
+func TestSellerParserResultCount(t *testing.T) {
+	p := NewParser()
+
+	tests := []struct {
+		region string
+		html   string
+		want   model.ResultCount
+	}{
+		{US, `<span>1-48 of over 10,000 results for</span><span class="a-color-state">"usb cable"</span>`, model.ResultCount{Start: 1, End: 48, Total: 10000, Over: true, Query: "usb cable"}},
+		{DE, `<span>49-96 von 1.234 Ergebnissen oder Vorschlägen für</span><span>"usb kabel"</span>`, model.ResultCount{Start: 49, End: 96, Total: 1234, Query: "usb kabel"}},
+		{FR, `<span>1-48 sur plus de 20 000 résultats pour</span>`, model.ResultCount{Start: 1, End: 48, Total: 20000, Over: true}},
+	}
+
+	for _, test := range tests {
+		doc, err := htmlquery.Parse(strings.NewReader(`<html><body>` + test.html + `</body></html>`))
 		if err != nil {
-			t.Errorf("Error parsing star: %s\n", err.Error())
-		} else {
-			fmt.Printf("Star: %v\n", star)
+			t.Fatalf("Error loading document: %s\n", err.Error())
 		}
 
-		title, err := parser.ParseTitle(node)
+		count, err := p.GetSellerParser(test.region).ParseResultCount(doc)
 		if err != nil {
-			t.Errorf("Error parsing title: %s\n", err.Error())
-		} else {
-			fmt.Printf("title: %v\n", title)
+			t.Errorf("Error parsing result count: %s\n", err.Error())
+		} else if *count != test.want {
+			t.Errorf("Unexpected result count for %v: %+v\n", test.region, count)
 		}
 	}
 }
 
-func TestProductParser(t *testing.T) {
-	p := NewParser()
-
-	doc, err := htmlquery.LoadDoc("./input.html")
+func TestPaginator(t *testing.T) {
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="de-de"><body>
+		<span class="s-pagination-strip">
+			<a class="s-pagination-item s-pagination-previous" href="/s?k=usb&amp;page=1&amp;qid=1700000000&amp;ref=sr_pg_1">Zurück</a>
+			<a class="s-pagination-item s-pagination-button" href="/s?k=usb&amp;page=1">1</a>
+			<span class="s-pagination-item s-pagination-selected" aria-label="Aktuelle Seite, Seite 2">2</span>
+			<a class="s-pagination-item s-pagination-button" href="/s?k=usb&amp;page=3">3</a>
+			<span class="s-pagination-item s-pagination-disabled">7</span>
+			<a class="s-pagination-item s-pagination-next" aria-label="Zur nächsten Seite, Seite 3" href="/s?k=usb&amp;page=3&amp;qid=1700000000&amp;ref=sr_pg_3">Weiter</a>
+		</span>
+	</body></html>`))
 	if err != nil {
 		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	region, err := ParseRegion(doc)
+	paginator, err := NewParser().NewPaginator(doc, DE, PageSearch)
 	if err != nil {
-		t.Fatalf("Error parsing region: %s\n", err.Error())
+		t.Fatalf("Error creating paginator: %s\n", err.Error())
 	}
 
-	parser := p.GetProductParser(region)
-	if parser == nil {
-		t.Fatal("No parser found for region: " + region)
+	if paginator.CurrentPage() != 2 || paginator.MaxPage() != 7 {
+		t.Errorf("Unexpected pages: %v of %v\n", paginator.CurrentPage(), paginator.MaxPage())
 	}
 
-	asin, err := parser.ParseASIN(doc)
-	if err != nil {
-		t.Errorf("Error parsing product asin: %s\n", err.Error())
-	} else {
-		fmt.Printf("asin: %s\n", asin)
+	next, err := paginator.NextPageURL()
+	if err != nil || next != "https://www.amazon.de/s?k=usb&page=3" {
+		t.Errorf("Unexpected next page url: %v, %v\n", next, err)
 	}
 
-	star, err := parser.ParseStar(doc)
-	if err != nil {
-		t.Errorf("Error parsing product star: %s\n", err.Error())
-	} else {
-		fmt.Printf("star: %s\n", star)
+	prev, err := paginator.PrevPageURL()
+	if err != nil || prev != "https://www.amazon.de/s?k=usb&page=1" {
+		t.Errorf("Unexpected previous page url: %v, %v\n", prev, err)
 	}
 
-	rating, err := parser.ParseRating(doc)
-	if err != nil {
-		t.Errorf("Error parsing product rating: %s\n", err.Error())
-	} else {
-		fmt.Printf("rating: %s\n", rating)
+	urls, err := paginator.PageURLs()
+	if err != nil || len(urls) != 7 || urls[0] != "https://www.amazon.de/s?k=usb" || urls[6] != "https://www.amazon.de/s?k=usb&page=7" {
+		t.Errorf("Unexpected page urls: %v, %v\n", urls, err)
 	}
 
-	title, err := parser.ParseTitle(doc)
-	if err != nil {
-		t.Errorf("Error parsing product title: %s\n", err.Error())
-	} else {
-		fmt.Printf("Title: %s\n", title)
+	if _, err := NewParser().NewPaginator(doc, DE, PageProduct); err == nil {
+		t.Errorf("Expected error for a page type without pagination\n")
 	}
+}
+
+func TestBoardAcpRequest(t *testing.T) {
+	p := NewParser()
 
-	price, err := parser.ParsePrice(doc)
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="en-us"><body><div id="a-page">
+		<div data-acp-params="tng=1&amp;pt=p13n" data-acp-path="/acp/p13n-zg-list-grid-desktop/abc/"></div>
+		<div class="p13n-desktop-grid" data-reftag="zg_bs_g_electronics" data-index-offset="0"
+			data-client-recs-list='[{"id":"B0RANK0001","metadataMap":{"render.zg.rank":"1"}},{"id":"B0RANK0002","metadataMap":{"render.zg.rank":"2"}},{"id":"B0RANK0003","metadataMap":{"render.zg.rank":"3"}}]'>
+			<div id="gridItemRoot"><div data-asin="B0RANK0001"><div><span>#1</span></div></div></div>
+		</div>
+	</div></body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing product price: %s\n", err.Error())
-	} else {
-		fmt.Printf("Price: %v\n", price)
+		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	img, err := parser.ParseImg(doc)
+	req, err := p.NewAcpRequest(doc, US)
 	if err != nil {
-		t.Errorf("Error parsing product img: %s\n", err.Error())
-	} else {
-		fmt.Printf("img: %v\n", img)
+		t.Fatalf("Error building acp request: %s\n", err.Error())
+	}
+	if req.URL.String() != "https://www.amazon.com/acp/p13n-zg-list-grid-desktop/abc/nextPage?tng=1&pt=p13n" {
+		t.Errorf("Unexpected url: %v\n", req.URL)
 	}
 
-	dispatchFrom, err := parser.ParseDispatchFrom(doc)
-	if err != nil {
-		t.Errorf("Error parsing product dispatchFrom: %s\n", err.Error())
-	} else {
-		fmt.Printf("dispatchFrom: %v\n", dispatchFrom)
+	var body struct {
+		IDs     []string `json:"ids"`
+		Indexes []int    `json:"indexes"`
+		Offset  string   `json:"offset"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		t.Fatalf("Error decoding body: %s\n", err.Error())
+	}
+	if len(body.IDs) != 2 || !strings.Contains(body.IDs[0], "B0RANK0002") || body.Indexes[1] != 2 || body.Offset != "1" {
+		t.Errorf("Unexpected body: %+v\n", body)
 	}
 
-	soldBy, err := parser.ParseSoldBy(doc)
+	items, err := p.ParseAcpResponse([]byte(`["dispatch","grid","<div id=\"gridItemRoot\"><div data-asin=\"B0RANK0002\"><div><span>#2</span></div></div></div>"]&&&`), US)
 	if err != nil {
-		t.Errorf("Error parsing product soldBy: %s\n", err.Error())
-	} else {
-		fmt.Printf("soldBy: %v\n", soldBy)
+		t.Fatalf("Error parsing acp response: %s\n", err.Error())
 	}
+	if len(items) != 1 || items[0].ASIN != "B0RANK0002" || items[0].Rank != 2 {
+		t.Errorf("Unexpected items: %+v\n", items)
+	}
+}
+
+func TestBoardParserRecsItems(t *testing.T) {
+	p := NewParser()
 
-	pkgDims, err := parser.ParsePackageDimensions(doc)
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="fr-fr"><body><div id="a-page">
+		<div data-reftag="zg_bs_g_electronics" data-index-offset="50"
+			data-client-recs-list='[{"id":"B0RANK0051","metadataMap":{"render.zg.rank":"51","render.zg.bsms.currentSalesRank":"1200"}},{"id":"B0RANK0052","metadataMap":{}}]'></div>
+	</div></body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing product pkgDims: %s\n", err.Error())
-	} else {
-		fmt.Printf("pkgDims: %v\n", pkgDims)
+		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	pkgWeight, err := parser.ParsePackageWeight(doc)
+	recs, err := p.GetBoardParser(FR).ParseRecsItems(doc)
 	if err != nil {
-		t.Errorf("Error parsing product pkgWeight: %s\n", err.Error())
-	} else {
-		fmt.Printf("pkgWeight: %v\n", pkgWeight)
+		t.Fatalf("Error parsing recs items: %s\n", err.Error())
+	}
+	if len(recs) != 2 || recs[0].ASIN != "B0RANK0051" || recs[0].Rank != 51 || recs[0].Metadata["render.zg.bsms.currentSalesRank"] != "1200" {
+		t.Errorf("Unexpected recs: %+v\n", recs[0])
 	}
+	if recs[1].Rank != 52 {
+		t.Errorf("Unexpected rank: %v\n", recs[1].Rank)
+	}
+}
 
-	firstDate, err := parser.ParseFirstAvailDate(doc)
+func TestProductParserRatingHistogram(t *testing.T) {
+	p := NewParser()
+
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="de-de"><body>
+		<span data-hook="rating-out-of-text">4,5 von 5</span>
+		<span data-hook="total-review-count">1.234 globale Bewertungen</span>
+		<ul id="histogramTable">
+			<li><span class="a-list-item"><a><div class="a-text-left">5 Sterne</div><div class="a-text-right">72 %</div></a></span></li>
+			<li><span class="a-list-item"><a><div class="a-text-left">4 Sterne</div><div class="a-text-right">18 %</div></a></span></li>
+			<li><span class="a-list-item"><a><div class="a-text-left">1 Stern</div><div class="a-text-right">3 %</div></a></span></li>
+		</ul>
+		<ul><li class="a-align-center a-spacing-none"><span class="a-list-item"><div class="a-text-left">2 Sterne</div></span></li></ul>
+	</body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing product firstDate: %s\n", err.Error())
-	} else {
-		fmt.Printf("firstDate: %v\n", firstDate)
+		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	sellerId, err := parser.ParseSellerId(doc)
+	parser := p.GetProductParser(DE)
+	histogram, err := parser.ParseRatingHistogram(doc)
 	if err != nil {
-		t.Errorf("Error parsing product sellerId: %s\n", err.Error())
-	} else {
-		fmt.Printf("sellerId: %v\n", sellerId)
+		t.Fatalf("Error parsing rating histogram: %s\n", err.Error())
 	}
 
-	categoryId, err := parser.ParseCategoryId(doc)
-	if err != nil {
-		t.Errorf("Error parsing product categoryId: %s\n", err.Error())
-	} else {
-		fmt.Printf("categoryId: %v\n", categoryId)
+	if histogram.Percent(5) != 72 || histogram.Percent(4) != 18 || histogram.Percent(1) != 3 || histogram.Percent(2) != 0 {
+		t.Errorf("Unexpected percentages: %v\n", histogram.Percentages)
+	}
+	if histogram.TotalRatings != 1234 || histogram.Average != 4.5 {
+		t.Errorf("Unexpected summary: %+v\n", histogram)
 	}
 
-	productDims, err := parser.ParseProductDimensions(doc)
+	reviews, err := parser.ParseCustomerReviews(doc)
 	if err != nil {
-		t.Errorf("Error parsing product productDims: %s\n", err.Error())
-	} else {
-		fmt.Printf("productDims: %v\n", productDims)
+		t.Fatalf("Error parsing customer reviews: %s\n", err.Error())
+	}
+	if reviews["2 Sterne"] != "unknown" {
+		t.Errorf("Unexpected customer reviews: %v\n", reviews)
 	}
 
-	itemWeight, err := parser.ParseProductWeight(doc)
+	doc, err = htmlquery.Parse(strings.NewReader(`<html lang="fr-fr"><body>
+		<span class="a-icon-alt">4,5 sur 5 étoiles</span>
+		<ul id="histogramTable">
+			<li><span class="a-list-item"><a><div class="a-text-left">5 étoiles</div><div class="a-text-right">72 %</div></a></span></li>
+		</ul>
+	</body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing product itemWeight: %s\n", err.Error())
-	} else {
-		fmt.Printf("itemWeight: %v\n", itemWeight)
+		t.Fatalf("Error loading document: %s\n", err.Error())
+	}
+	histogram, err = p.GetProductParser(FR).ParseRatingHistogram(doc)
+	if err != nil || histogram.Percent(5) != 72 || histogram.Average != 4.5 {
+		t.Errorf("Unexpected histogram with star fallback: %+v, %v\n", histogram, err)
 	}
+}
+
+func TestProductParserReviewInsights(t *testing.T) {
+	p := NewParser()
 
-	hasCart, err := parser.ParseHasCart(doc)
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="en-us"><body>
+		<div id="product-summary"><h3>Customers say</h3><p><span>Customers like the quality and value.</span></p></div>
+		<div data-hook="cr-insights-widget-aspects">
+			<button aria-describedby="aspect-quality"><i class="a-icon a-icon-customer-insights-positive"></i><span>Quality</span></button>
+			<button aria-label="40 customers mention noise, 10 positive, 30 negative"><i class="a-icon a-icon-customer-insights-negative"></i><span>Noise</span></button>
+		</div>
+		<div id="aspect-quality">132 customers mention "Quality", 120 positive, 12 negative</div>
+	</body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing product hasCart: %s\n", err.Error())
-	} else {
-		fmt.Printf("hasCart: %v\n", hasCart)
+		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	coupon, err := parser.ParseCoupon(doc)
+	insights, err := p.GetProductParser(US).ParseReviewInsights(doc)
 	if err != nil {
-		t.Errorf("Error parsing product coupon: %s\n", err.Error())
-	} else {
-		fmt.Printf("coupon: %v\n", coupon)
+		t.Fatalf("Error parsing review insights: %s\n", err.Error())
 	}
 
-	color, err := parser.ParseColor(doc)
-	if err != nil {
-		t.Errorf("Error parsing product color: %s\n", err.Error())
-	} else {
-		fmt.Printf("color: %v\n", color)
+	if insights.Summary != "Customers like the quality and value." || len(insights.Aspects) != 2 {
+		t.Fatalf("Unexpected insights: %+v\n", insights)
 	}
 
-	size, err := parser.ParseSize(doc)
+	quality, noise := insights.Aspects[0], insights.Aspects[1]
+	if quality.Name != "Quality" || quality.Sentiment != model.SentimentPositive || quality.Mentions != 132 || quality.Positive != 120 || quality.Negative != 12 {
+		t.Errorf("Unexpected aspect: %+v\n", quality)
+	}
+	if noise.Sentiment != model.SentimentNegative || noise.Mentions != 40 || noise.Negative != 30 {
+		t.Errorf("Unexpected aspect: %+v\n", noise)
+	}
+}
+
+func TestProductParserTopReviews(t *testing.T) {
+	p := NewParser()
+
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="en-us"><body>
+		<div id="cm-cr-dp-review-list">
+			<div id="R1LOCAL" data-hook="review">
+				<div id="customer_review-R1LOCAL">
+					<div><a class="a-profile" href="/gp/profile/amzn1.account.A"><div class="a-profile-content"><span class="a-profile-name">Jane</span></div></a></div>
+					<a data-hook="review-title"><i><span class="a-icon-alt">4.0 out of 5 stars</span></i><span class="a-letter-space"></span><span>Works well</span></a>
+					<span data-hook="review-date">Reviewed in the United States on May 3, 2024</span>
+					<span data-hook="review-body"><span>Solid cable.</span></span>
+				</div>
+			</div>
+		</div>
+		<div id="cm-cr-global-review-list">
+			<div id="R2FOREIGN" data-hook="review">
+				<div id="customer_review_foreign-R2FOREIGN">
+					<span data-hook="review-title"><i><span class="a-icon-alt">5.0 out of 5 stars</span></i><span>Super</span></span>
+					<span data-hook="review-date">Reviewed in Germany on 1 April 2024</span>
+					<span data-hook="review-body"><span>Sehr gut.</span></span>
+				</div>
+			</div>
+		</div>
+	</body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing product size: %s\n", err.Error())
-	} else {
-		fmt.Printf("size: %v\n", size)
+		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	specs, err := parser.ParseSpecs(doc)
+	parser := p.GetProductParser(US)
+	local, err := parser.ParseLocalReviews(doc)
 	if err != nil {
-		t.Errorf("Error parsing product specs: %s\n", err.Error())
-	} else {
-		fmt.Printf("specs: %v\n", specs)
+		t.Fatalf("Error parsing local reviews: %s\n", err.Error())
+	}
+	if len(local) != 1 || local[0].Title != "Works well" || local[0].Reviewer != "Jane" || local[0].Star != 4 ||
+		local[0].Date != "May 3, 2024" || local[0].Content != "Solid cable." {
+		t.Errorf("Unexpected local reviews: %+v\n", local[0])
 	}
 
-	desc, err := parser.ParseDescription(doc)
+	foreign, err := parser.ParseForeignReviews(doc)
 	if err != nil {
-		t.Errorf("Error parsing product desc: %s\n", err.Error())
-	} else {
-		fmt.Printf("desc: %v\n", desc)
+		t.Fatalf("Error parsing foreign reviews: %s\n", err.Error())
 	}
+	if len(foreign) != 1 || foreign[0].Title != "Super" || foreign[0].Date != "1 April 2024" || foreign[0].Star != 5 {
+		t.Errorf("Unexpected foreign reviews: %+v\n", foreign[0])
+	}
+}
+
+func TestReviewParserDetails(t *testing.T) {
+	p := NewParser()
 
-	deliveryTime, err := parser.ParseDeliveryTime(doc)
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="de-de"><body>
+		<li id="R3VINE" data-hook="review">
+			<div id="customer_review-R3VINE">
+				<div><a class="a-profile" href="/gp/profile/amzn1.account.B"><div class="a-profile-content"><span class="a-profile-name">Max</span></div></a></div>
+				<a data-hook="review-title"><i><span class="a-icon-alt">5,0 von 5 Sternen</span></i><span>Top</span></a>
+				<span data-hook="review-date">Rezension aus Deutschland vom 2. März 2024 · Bearbeitet</span>
+				<a data-hook="format-strip">Farbe: Schwarz<i class="a-icon a-icon-text-separator"></i>Größe: L</a>
+				<span data-hook="linkless-vine-review-badge"><span>Vine Kundenrezension eines kostenlosen Produkts</span></span>
+				<span data-hook="review-body"><span>Update: passt immer noch.</span></span>
+				<div class="review-image-tile-section"><img data-hook="review-image-tile" src="https://m.media-amazon.com/images/I/a.jpg"/><img data-hook="review-image-tile" src="https://m.media-amazon.com/images/I/b.jpg"/></div>
+				<div data-hook="review-video" data-video-url="https://m.media-amazon.com/videos/v.mp4"></div>
+				<span data-hook="helpful-vote-statement">Eine Person fand diese Informationen hilfreich</span>
+			</div>
+		</li>
+		<li id="R4PLAIN" data-hook="review">
+			<div id="customer_review-R4PLAIN">
+				<span data-hook="review-date">Rezension aus Deutschland vom 1. März 2024</span>
+				<span data-hook="review-body"><span>Gut. Update: Bearbeitet habe ich nichts.</span></span>
+				<span data-hook="helpful-vote-statement">1.234 Personen fanden diese Informationen hilfreich</span>
+			</div>
+		</li>
+	</body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing product deliveryTime: %s\n", err.Error())
-	} else {
-		fmt.Printf("deliveryTime: %v\n", deliveryTime)
+		t.Fatalf("Error loading document: %s\n", err.Error())
+	}
+
+	parser := p.GetReviewParser(DE)
+	nodes, err := parser.ParseAllReviews(doc)
+	if err != nil || len(nodes) != 2 {
+		t.Fatalf("Error parsing all reviews: %v\n", err)
 	}
 
-	fastestDelivery, err := parser.ParseFastestDelivery(doc)
+	review, err := parser.ParseReview(nodes[0])
 	if err != nil {
-		t.Errorf("Error parsing product fastestDelivery: %s\n", err.Error())
-	} else {
-		fmt.Printf("fastestDelivery: %v\n", fastestDelivery)
+		t.Fatalf("Error parsing review: %s\n", err.Error())
+	}
+	if review.HelpfulVotes != 1 || !review.Vine || !review.Edited || review.Variant != "Farbe: Schwarz | Größe: L" ||
+		len(review.Images) != 2 || len(review.Videos) != 1 {
+		t.Errorf("Unexpected review: %+v\n", review)
 	}
 
-	primePrice, err := parser.ParsePrimePrice(doc)
+	review, err = parser.ParseReview(nodes[1])
 	if err != nil {
-		t.Errorf("Error parsing product primePrice: %s\n", err.Error())
-	} else {
-		fmt.Printf("primePrice: %v\n", primePrice)
+		t.Fatalf("Error parsing review: %s\n", err.Error())
+	}
+	if review.HelpfulVotes != 1234 || review.Vine || review.Edited || review.Variant != "" || len(review.Images) != 0 {
+		t.Errorf("Unexpected review: %+v\n", review)
 	}
+}
+
+func TestReviewParserVerified(t *testing.T) {
+	p := NewParser()
 
-	brand, err := parser.ParseBrand(doc)
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="fr-fr"><body>
+		<li id="R5BADGE" data-hook="review">
+			<div id="customer_review-R5BADGE">
+				<div><a class="a-profile" href="/gp/profile/amzn1.account.C/ref=cm_cr_arp_d_gw_btm"><div class="a-profile-content"><span class="a-profile-name">Claire</span></div></a></div>
+				<div><a class="a-link-normal" data-hook="review-title" href="/gp/customer-reviews/R5BADGE"><span>Parfait</span></a></div>
+				<div><a class="a-link-normal" href="#"><span data-hook="avp-badge">Achat vérifié</span></a></div>
+				<span data-hook="review-body"><span>Très bien.</span></span>
+			</div>
+		</li>
+		<li id="R6NOBADGE" data-hook="review">
+			<div id="customer_review-R6NOBADGE">
+				<div><a class="a-link-normal" data-hook="review-title" href="/gp/customer-reviews/R6NOBADGE"><span>Correct</span></a></div>
+				<div><a class="a-link-normal" href="#"><span>Achat vérifié</span></a></div>
+				<span data-hook="review-body"><span>Ça marche.</span></span>
+			</div>
+		</li>
+	</body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing product brand: %s\n", err.Error())
-	} else {
-		fmt.Printf("brand: %v\n", brand)
+		t.Fatalf("Error loading document: %s\n", err.Error())
+	}
+
+	parser := p.GetReviewParser(FR)
+	nodes, err := parser.ParseAllReviews(doc)
+	if err != nil || len(nodes) != 2 {
+		t.Fatalf("Error parsing all reviews: %v\n", err)
+	}
+
+	link, err := parser.ParseReviewerLink(nodes[0])
+	if err != nil || link != "/gp/profile/amzn1.account.C/ref=cm_cr_arp_d_gw_btm" {
+		t.Errorf("Unexpected reviewer link: %v, %v\n", link, err)
 	}
 
-	categoryHierarchy, err := parser.ParseCategoryHierarchy(doc)
+	review, err := parser.ParseReview(nodes[0])
 	if err != nil {
-		t.Errorf("Error parsing product categoryHierarchy: %s\n", err.Error())
-	} else {
-		fmt.Printf("categoryHierarchy: %v\n", categoryHierarchy)
+		t.Fatalf("Error parsing review: %s\n", err.Error())
+	}
+	if !review.Verified || review.Reviewer != "Claire" || review.ReviewerLink != "https://www.amazon.fr/gp/profile/amzn1.account.C" {
+		t.Errorf("Unexpected review with badge: %+v\n", review)
 	}
 
-	customerReviews, err := parser.ParseCustomerReviews(doc)
+	review, err = parser.ParseReview(nodes[1])
 	if err != nil {
-		t.Errorf("Error parsing product customerReviews: %s\n", err.Error())
-	} else {
-		fmt.Printf("customerReviews: %v\n", customerReviews)
+		t.Fatalf("Error parsing review: %s\n", err.Error())
+	}
+	if review.Verified || review.Reviewer != "" || review.ReviewerLink != "" || review.Title != "Correct" {
+		t.Errorf("Unexpected review without badge: %+v\n", review)
 	}
 }
 
-func TestBoardParser(t *testing.T) {
+func TestReviewParserIDAndMerge(t *testing.T) {
 	p := NewParser()
 
-	doc, err := htmlquery.LoadDoc("./input.html")
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="en-us"><body>
+		<li id="R1ABC2DEF3GHI4" data-hook="review">
+			<div id="customer_review-R1ABC2DEF3GHI4">
+				<a data-hook="review-title" href="/gp/customer-reviews/R1ABC2DEF3GHI4/ref=cm_cr_arp_d_rvw_ttl?ie=UTF8"><span>Old</span></a>
+				<span data-hook="review-date">Reviewed in the United States on May 3, 2024</span>
+			</div>
+		</li>
+		<li data-hook="review">
+			<div id="customer_review-R2XYZ2DEF3GHI4">
+				<span data-hook="review-title"><span>New</span></span>
+				<span data-hook="review-date">Reviewed in the United States on June 1, 2024</span>
+			</div>
+		</li>
+	</body></html>`))
 	if err != nil {
 		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	region, err := ParseRegion(doc)
-	if err != nil {
-		t.Fatalf("Error parsing region: %s\n", err.Error())
+	parser := p.GetReviewParser(US)
+	nodes, err := parser.ParseAllReviews(doc)
+	if err != nil || len(nodes) != 2 {
+		t.Fatalf("Error parsing all reviews: %v\n", err)
 	}
 
-	parser := p.GetBoardParser(region)
-	if parser == nil {
-		t.Fatal("No parser found for region: " + region)
+	var batch []*model.Review
+	for _, node := range nodes {
+		review, err := parser.ParseReview(node)
+		if err != nil {
+			t.Fatalf("Error parsing review: %s\n", err.Error())
+		}
+		batch = append(batch, review)
+	}
+	if batch[0].ID != "R1ABC2DEF3GHI4" || batch[0].Permalink != "https://www.amazon.com/gp/customer-reviews/R1ABC2DEF3GHI4" {
+		t.Errorf("Unexpected review: %+v\n", batch[0])
+	}
+	if batch[1].ID != "R2XYZ2DEF3GHI4" || batch[1].Permalink != "https://www.amazon.com/gp/customer-reviews/R2XYZ2DEF3GHI4" {
+		t.Errorf("Unexpected review: %+v\n", batch[1])
 	}
 
-	nextPage, err := parser.ParseNextPageURL(doc)
-	if err != nil {
-		t.Errorf("Error parsing next page: %s\n", err.Error())
-	} else {
-		fmt.Printf("Next page url: %s\n", nextPage)
+	updated := *batch[0]
+	updated.Title = "Old, updated"
+	reviews := MergeReviews(batch, []*model.Review{&updated})
+	if len(reviews) != 2 || reviews[0].ID != "R2XYZ2DEF3GHI4" || reviews[1].Title != "Old, updated" {
+		t.Errorf("Unexpected merged reviews: %+v %+v\n", reviews[0], reviews[1])
 	}
+}
 
-	recsList, err := parser.ParseRecsList(doc)
-	if err != nil {
-		t.Errorf("Error parsing recsList: %s\n", err.Error())
-	} else {
-		fmt.Printf("recsList: %s\n", recsList)
+func TestReviewParserLayouts(t *testing.T) {
+	p := NewParser()
+
+	layouts := map[string]string{
+		"li": `<li id="R1ABC2DEF3GHI4" data-hook="review">
+				<div id="customer_review-R1ABC2DEF3GHI4"><span data-hook="review-title"><span>First</span></span><span data-hook="review-body"><span>One.</span></span></div>
+			</li>
+			<li id="R2ABC2DEF3GHI4" data-hook="review">
+				<div id="customer_review-R2ABC2DEF3GHI4"><span data-hook="review-title"><span>Second</span></span><span data-hook="review-body"><span>Two.</span></span></div>
+			</li>`,
+		"div": `<div id="cm_cr-review_list">
+				<div id="R1ABC2DEF3GHI4" data-hook="review">
+					<div id="customer_review-R1ABC2DEF3GHI4"><span data-hook="review-title"><span>First</span></span><span data-hook="review-body"><span>One.</span></span></div>
+				</div>
+				<div id="R2ABC2DEF3GHI4" data-hook="review">
+					<div id="customer_review-R2ABC2DEF3GHI4"><span data-hook="review-title"><span>Second</span></span><span data-hook="review-body"><span>Two.</span></span></div>
+				</div>
+			</div>`,
+		"legacy": `<div review="true" id="R1ABC2DEF3GHI4"><div id="customer_review-R1ABC2DEF3GHI4"><span data-hook="review-title"><span>First</span></span><span data-hook="review-body"><span>One.</span></span></div></div>
+			<div review="true" id="R2ABC2DEF3GHI4"><div id="customer_review-R2ABC2DEF3GHI4"><span data-hook="review-title"><span>Second</span></span><span data-hook="review-body"><span>Two.</span></span></div></div>`,
+	}
+
+	for _, region := range []string{US, UK, DE, FR} {
+		for name, layout := range layouts {
+			doc, err := htmlquery.Parse(strings.NewReader(`<html><body>` + layout + `</body></html>`))
+			if err != nil {
+				t.Fatalf("Error loading document: %s\n", err.Error())
+			}
+
+			parser := p.GetReviewParser(region)
+			nodes, err := parser.ParseAllReviews(doc)
+			if err != nil || len(nodes) != 2 {
+				t.Errorf("%v %v: unexpected review containers: %v %v\n", region, name, len(nodes), err)
+				continue
+			}
+
+			review, err := parser.ParseReview(nodes[1])
+			if err != nil || review.ID != "R2ABC2DEF3GHI4" || review.Title != "Second" || review.Content != "Two." {
+				t.Errorf("%v %v: unexpected review: %+v %v\n", region, name, review, err)
+			}
+		}
 	}
+}
+
+func TestExtract(t *testing.T) {
+	p := NewParser()
 
-	reftag, err := parser.ParseReftag(doc)
+	doc, err := htmlquery.Parse(strings.NewReader(`<html lang="en-US"><body>
+		<input id="twotabsearchtextbox" value="usb cable"/>
+		<div class="s-result-item" data-asin="B0ABCDEFGH" data-index="1" data-uuid="a">
+			<div><h2 class="a-text-normal"><span>Cable A</span></h2><span class="a-price"><span class="a-offscreen">$9.99</span></span></div>
+		</div>
+		<div class="s-result-item AdHolder" data-asin="B0ABCDEFGJ" data-index="2" data-uuid="b">
+			<div><span>Sponsored</span><h2 class="a-text-normal"><span>Cable B</span></h2></div>
+		</div>
+	</body></html>`))
 	if err != nil {
-		t.Errorf("Error parsing reftag: %s\n", err.Error())
-	} else {
-		fmt.Printf("reftag: %s\n", reftag)
+		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	offset, err := parser.ParseOffset(doc)
-	if err != nil {
-		t.Errorf("Error parsing offset: %s\n", err.Error())
-	} else {
-		fmt.Printf("offset: %s\n", offset)
+	pageType, err := DetectPageType(doc)
+	if err != nil || pageType != PageSearch {
+		t.Fatalf("Unexpected page type: %v %v\n", pageType, err)
 	}
 
-	acpParam, err := parser.ParseAcpParam(doc)
+	result, err := p.Extract(doc, "", "")
 	if err != nil {
-		t.Errorf("Error parsing acpParam: %s\n", err.Error())
-	} else {
-		fmt.Printf("acpParam: %s\n", acpParam)
+		t.Fatalf("Error extracting record: %s\n", err.Error())
+	}
+	if result.Region != US || result.PageType != PageSearch {
+		t.Errorf("Unexpected result: %+v\n", result)
 	}
 
-	acpPath, err := parser.ParseAcpPath(doc)
-	if err != nil {
-		t.Errorf("Error parsing acpPath: %s\n", err.Error())
-	} else {
-		fmt.Printf("acpPath: %s\n", acpPath)
+	record, ok := result.Record.(*model.ListingRecord)
+	if !ok || record.Keyword != "usb cable" || len(record.Items) != 2 {
+		t.Fatalf("Unexpected record: %+v\n", result.Record)
+	}
+	if record.Items[0].Title != "Cable A" || record.Items[0].Price != "$9.99" || !record.Items[1].Sponsored {
+		t.Errorf("Unexpected items: %+v %+v\n", record.Items[0], record.Items[1])
 	}
 
-	nodes, err := parser.ParseAllProducts(doc)
+	failed := make(map[string]bool)
+	for _, e := range result.Errors {
+		failed[e.Field] = true
+	}
+	if !failed["items[1].price"] || failed["items[0].price"] || failed["keyword"] {
+		t.Errorf("Unexpected field errors: %+v\n", result.Errors)
+	}
+
+	if _, err := p.Extract(doc, "ja-jp", PageSearch); err == nil {
+		t.Errorf("Expected an error for an unsupported region\n")
+	}
+}
+
+// TestJSONSchemaCompatibility fails if a field of the published schema was
+// renamed, removed, retyped or made optional. Such changes need a new
+// model.SchemaVersion.
+func TestJSONSchemaCompatibility(t *testing.T) {
+	published := loadSchema(t, "schema/"+model.SchemaVersion+"/result.schema.json")
+
+	data, err := JSONSchema()
 	if err != nil {
-		t.Fatalf("Error parsing products: %s\n", err.Error())
+		t.Fatalf("Error generating schema: %s\n", err.Error())
+	}
+	var current map[string]interface{}
+	if err := json.Unmarshal(data, &current); err != nil {
+		t.Fatalf("Error decoding schema: %s\n", err.Error())
 	}
 
-	for _, node := range nodes {
-		asin, err := parser.ParseASIN(node)
-		if err != nil {
-			t.Errorf("Error parsing asin: %s\n", err.Error())
-		} else {
-			fmt.Printf("asin: %s\n", asin)
+	objects := map[string]map[string]interface{}{"Result": published}
+	if defs, ok := published["$defs"].(map[string]interface{}); ok {
+		for name, def := range defs {
+			objects[name], _ = def.(map[string]interface{})
 		}
+	}
 
-		price, err := parser.ParsePrice(node)
-		if err != nil {
-			t.Errorf("Error parsing price: %s\n", err.Error())
-		} else {
-			fmt.Printf("price: %s\n", price)
+	currentDefs, _ := current["$defs"].(map[string]interface{})
+	for name, object := range objects {
+		now := current
+		if name != "Result" {
+			now, _ = currentDefs[name].(map[string]interface{})
 		}
-
-		star, err := parser.ParseStar(node)
-		if err != nil {
-			t.Errorf("Error parsing star: %s\n", err.Error())
-		} else {
-			fmt.Printf("star: %s\n", star)
+		if now == nil {
+			t.Errorf("%v: definition removed\n", name)
+			continue
 		}
 
-		rating, err := parser.ParseRating(node)
-		if err != nil {
-			t.Errorf("Error parsing rating: %s\n", err.Error())
-		} else {
-			fmt.Printf("rating: %s\n", rating)
+		properties, _ := object["properties"].(map[string]interface{})
+		nowProperties, _ := now["properties"].(map[string]interface{})
+		for field, schema := range properties {
+			nowSchema, ok := nowProperties[field]
+			if !ok {
+				t.Errorf("%v.%v: field renamed or removed\n", name, field)
+				continue
+			}
+			if !reflect.DeepEqual(schema, nowSchema) {
+				t.Errorf("%v.%v: field type changed from %v to %v\n", name, field, schema, nowSchema)
+			}
 		}
 
-		title, err := parser.ParseTitle(node)
-		if err != nil {
-			t.Errorf("Error parsing title: %s\n", err.Error())
-		} else {
-			fmt.Printf("title: %s\n", title)
+		nowRequired := make(map[interface{}]bool)
+		if fields, ok := now["required"].([]interface{}); ok {
+			for _, field := range fields {
+				nowRequired[field] = true
+			}
 		}
-
-		rank, err := parser.ParseRank(node)
-		if err != nil {
-			t.Errorf("Error parsing rank: %s\n", err.Error())
-		} else {
-			fmt.Printf("rank: %s\n", rank)
+		required, _ := object["required"].([]interface{})
+		for _, field := range required {
+			if !nowRequired[field] {
+				t.Errorf("%v.%v: field no longer required\n", name, field)
+			}
 		}
 	}
 }
 
-func TestKeywordParser(t *testing.T) {
-	p := NewParser()
+// TestJSONSchemaUpToDate fails if fields were added without regenerating
+// the published schema with go generate.
+func TestJSONSchemaUpToDate(t *testing.T) {
+	published, err := os.ReadFile("schema/" + model.SchemaVersion + "/result.schema.json")
+	if err != nil {
+		t.Fatalf("Error reading schema: %s\n", err.Error())
+	}
 
-	doc, err := htmlquery.LoadDoc("./input.html")
+	data, err := JSONSchema()
 	if err != nil {
-		t.Fatalf("Error loading document: %s\n", err.Error())
+		t.Fatalf("Error generating schema: %s\n", err.Error())
 	}
+	if strings.TrimSpace(string(published)) != strings.TrimSpace(string(data)) {
+		t.Errorf("The published schema is out of date, run go generate\n")
+	}
+}
 
-	region, err := ParseRegion(doc)
+func loadSchema(t *testing.T, path string) map[string]interface{} {
+	data, err := os.ReadFile(path)
 	if err != nil {
-		t.Fatalf("Error parsing region: %s\n", err.Error())
+		t.Fatalf("Error reading schema: %s\n", err.Error())
 	}
 
-	parser := p.GetKeywordParser(region)
-	if parser == nil {
-		t.Fatal("No parser found for region: " + region)
+	var schema map[string]interface{}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("Error decoding schema: %s\n", err.Error())
 	}
+	return schema
+}
 
-	pageIndex, err := parser.ParseCurrentPageIndex(doc)
+// TestGolden extracts every testdata/{region}/{pagetype}/*.html page and
+// compares the result with the .golden.json file next to it. Run with
+// -update to regenerate the golden files after an intended change.
+func TestGolden(t *testing.T) {
+	p := NewParser()
+
+	files, err := filepath.Glob("testdata/*/*/*.html")
 	if err != nil {
-		t.Errorf("Error parsing current page index: %s\n", err.Error())
-	} else {
-		fmt.Printf("current page index: %s\n", pageIndex)
+		t.Fatalf("Error listing testdata: %s\n", err.Error())
+	}
+	if len(files) == 0 {
+		t.Fatal("No testdata pages found")
+	}
+
+	for _, file := range files {
+		file := file
+		t.Run(filepath.ToSlash(file), func(t *testing.T) {
+			dir, _ := filepath.Split(file)
+			region := filepath.Base(filepath.Dir(filepath.Clean(dir)))
+			pageType := filepath.Base(filepath.Clean(dir))
+
+			doc, err := htmlquery.LoadDoc(file)
+			if err != nil {
+				t.Fatalf("Error loading document: %s\n", err.Error())
+			}
+			result, err := p.Extract(doc, region, pageType)
+			if err != nil {
+				t.Fatalf("Error extracting record: %s\n", err.Error())
+			}
+			got, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				t.Fatalf("Error encoding result: %s\n", err.Error())
+			}
+			got = append(got, '\n')
+
+			golden := strings.TrimSuffix(file, ".html") + ".golden.json"
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatalf("Error writing golden file: %s\n", err.Error())
+				}
+				return
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("Error reading golden file, run with -update: %s\n", err.Error())
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("Result differs from %v, run with -update if intended:\n%v\n", golden, lineDiff(string(want), string(got)))
+			}
+		})
 	}
+}
 
-	nextPage, err := parser.ParseNextPageURL(doc)
-	if err != nil {
-		t.Errorf("Error parsing next page: %s\n", err.Error())
-	} else {
-		fmt.Printf("Next page url: %s\n", nextPage)
+// lineDiff returns the lines that differ between want and got.
+func lineDiff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	var diff strings.Builder
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			fmt.Fprintf(&diff, "line %d:\n- %s\n+ %s\n", i+1, w, g)
+		}
 	}
+	return diff.String()
+}
 
-	keyword, err := parser.ParseKeyword(doc)
+func TestDiagnose(t *testing.T) {
+	p := NewParser()
+
+	doc, err := htmlquery.LoadDoc("testdata/en-us/product/basic.html")
 	if err != nil {
-		t.Errorf("Error parsing keyword: %s\n", err.Error())
-	} else {
-		fmt.Printf("keyword: %s\n", keyword)
+		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
 
-	nodes, err := parser.ParseAllProducts(doc)
+	result, diagnostics, err := p.Diagnose(doc, US, PageProduct)
 	if err != nil {
-		t.Fatalf("Error parsing products: %s\n", err.Error())
+		t.Fatalf("Error diagnosing document: %s\n", err.Error())
+	}
+	if record, ok := result.Record.(*model.ProductRecord); !ok || record.Title == "" {
+		t.Fatalf("Unexpected record: %+v\n", result.Record)
 	}
 
-	for _, node := range nodes {
-		asin, err := parser.ParseASIN(node)
-		if err != nil {
-			t.Errorf("Error parsing asin: %s\n", err.Error())
-		} else {
-			fmt.Printf("ASIN: %s\n", asin)
-		}
-
-		price, err := parser.ParsePrice(node)
-		if err != nil {
-			t.Errorf("Error parsing price: %s\n", err.Error())
-		} else {
-			fmt.Printf("Price: %v\n", price)
+	var title *utils.Call
+	for _, call := range diagnostics.Calls {
+		if call.Method == "USProductParser.ParseTitle" {
+			title = call
 		}
+	}
+	if title == nil || len(title.Attempts) == 0 || len(title.Winners) != 1 {
+		t.Fatalf("Unexpected title call: %+v\n", title)
+	}
+	if last := title.Attempts[len(title.Attempts)-1]; last.Expr != title.Winners[0] || last.Matches != 1 || !last.Accepted {
+		t.Errorf("Unexpected title attempts: %+v\n", title.Attempts)
+	}
 
-		star, err := parser.ParseStar(node)
-		if err != nil {
-			t.Errorf("Error parsing star: %s\n", err.Error())
-		} else {
-			fmt.Printf("Star: %v\n", star)
-		}
-
-		rating, err := parser.ParseRating(node)
-		if err != nil {
-			t.Errorf("Error parsing rating: %s\n", err.Error())
-		} else {
-			fmt.Printf("rating: %v\n", rating)
+	for _, call := range diagnostics.Calls {
+		if call.Method == "USProductParser.ParsePriceInfo" && len(call.Winners) < 2 {
+			t.Errorf("Expected a winner per price lookup: %+v\n", call)
 		}
+	}
 
-		sponsered, err := parser.ParseSponsered(node)
-		if err != nil {
-			t.Errorf("Error parsing sponsered: %s\n", err.Error())
-		} else {
-			fmt.Printf("sponsered: %v\n", sponsered)
+	report := NewSelectorReport()
+	report.Add(diagnostics)
+	report.Add(diagnostics)
+	if report.Pages != 2 || len(report.Selectors) == 0 {
+		t.Fatalf("Unexpected report: %+v\n", report)
+	}
+	for _, stats := range report.Selectors {
+		if stats.Expr == title.Winners[0] && stats.Method == title.Method && (stats.Tried != 2 || stats.Won != 2) {
+			t.Errorf("Unexpected title stats: %+v\n", stats)
 		}
-
-		prime, err := parser.ParsePrime(node)
-		if err != nil {
-			t.Errorf("Error parsing prime: %s\n", err.Error())
-		} else {
-			fmt.Printf("prime: %v\n", prime)
+	}
+	for _, stats := range report.Dead() {
+		if stats.Matched != 0 {
+			t.Errorf("Unexpected dead selector: %+v\n", stats)
 		}
+	}
 
-		sales, err := parser.ParseSales(node)
-		if err != nil {
-			t.Errorf("Error parsing sales: %s\n", err.Error())
-		} else {
-			fmt.Printf("sales: %v\n", sales)
-		}
+	if _, again, _ := p.Diagnose(doc, US, PageProduct); len(again.Calls) != len(diagnostics.Calls) {
+		t.Errorf("Unexpected calls after a second trace: %v != %v\n", len(again.Calls), len(diagnostics.Calls))
+	}
 
-		img, err := parser.ParseImg(node)
-		if err != nil {
-			t.Errorf("Error parsing img: %s\n", err.Error())
-		} else {
-			fmt.Printf("img: %v\n", img)
+	doc, err = htmlquery.Parse(strings.NewReader(`<html lang="en-us"><body>
+		<li class="a-align-center a-spacing-none"><span class="a-list-item">
+			<div class="a-text-left">5 star</div><div class="a-text-left">4 star</div>
+		</span></li>
+	</body></html>`))
+	if err != nil {
+		t.Fatalf("Error loading document: %s\n", err.Error())
+	}
+	if _, diagnostics, err = p.Diagnose(doc, US, PageProduct); err != nil {
+		t.Fatalf("Error diagnosing document: %s\n", err.Error())
+	}
+	for _, call := range diagnostics.Calls {
+		if call.Method != "USProductParser.ParseCustomerReviews" {
+			continue
 		}
-
-		title, err := parser.ParseTitle(node)
-		if err != nil {
-			t.Errorf("Error parsing title: %s\n", err.Error())
-		} else {
-			fmt.Printf("title: %v\n", title)
+		for _, attempt := range call.Attempts {
+			if attempt.Matches == 2 && (attempt.Accepted || call.Won(attempt.Expr)) {
+				t.Errorf("Rejected single node lookup won: %+v\n", call)
+			}
 		}
 	}
 }
 
-func TestReviewParser(t *testing.T) {
-	p := NewParser()
-
-	doc, err := htmlquery.LoadDoc("./input.html")
+func TestTraceShared(t *testing.T) {
+	doc, err := htmlquery.LoadDoc("testdata/en-us/product/basic.html")
 	if err != nil {
 		t.Fatalf("Error loading document: %s\n", err.Error())
 	}
+	parser := NewParser().GetProductParser(US)
 
-	parser := p.GetReviewParser("en-us")
-	if parser == nil {
-		t.Fatal("No parser found for region: " + "en-us")
+	first := utils.StartTrace(doc)
+	second := utils.StartTrace(doc)
+	if first != second {
+		t.Fatalf("Expected the trace of the document to be shared\n")
 	}
 
-	nodes, err := parser.ParseAllReviews(doc)
-	if err != nil {
-		t.Fatalf("Error parsing reviews: %s\n", err.Error())
+	utils.StopTrace(doc)
+	parser.ParseTitle(doc)
+	if len(second.Calls()) != 1 {
+		t.Errorf("Expected the trace to record until its last stop: %+v\n", second.Calls())
 	}
 
-	for _, node := range nodes {
-		reviewer, err := parser.ParseReviewer(node)
-		if err != nil {
-			t.Errorf("Error parsing reviewer: %s\n", err.Error())
-		} else {
-			fmt.Printf("reviewer: %v\n", reviewer)
-		}
+	utils.StopTrace(doc)
+	parser.ParseTitle(doc)
+	if len(second.Calls()) != 1 {
+		t.Errorf("Expected the trace to be stopped: %+v\n", second.Calls())
+	}
+}
 
-		reviewerLink, err := parser.ParseReviewerLink(node)
-		if err != nil {
-			t.Errorf("Error parsing reviewerLink: %s\n", err.Error())
-		} else {
-			fmt.Printf("reviewerLink: %v\n", reviewerLink)
-		}
+func TestDetectDrift(t *testing.T) {
+	p := NewParser()
 
-		star, err := parser.ParseStar(node)
+	baseline := NewFillRates()
+	current := NewFillRates()
+	for i := 0; i < 2; i++ {
+		doc, err := htmlquery.LoadDoc("testdata/en-us/product/basic.html")
 		if err != nil {
-			t.Errorf("Error parsing star: %s\n", err.Error())
-		} else {
-			fmt.Printf("star: %v\n", star)
+			t.Fatalf("Error loading document: %s\n", err.Error())
 		}
-
-		title, err := parser.ParseTitle(node)
+		result, err := p.Extract(doc, US, PageProduct)
 		if err != nil {
-			t.Errorf("Error parsing title: %s\n", err.Error())
-		} else {
-			fmt.Printf("title: %v\n", title)
+			t.Fatalf("Error extracting record: %s\n", err.Error())
 		}
+		baseline.Add(result)
 
-		date, err := parser.ParseDate(node)
-		if err != nil {
-			t.Errorf("Error parsing date: %s\n", err.Error())
-		} else {
-			fmt.Printf("date: %v\n", date)
+		if i == 0 {
+			title := htmlquery.FindOne(doc, `//span[@id='productTitle']`)
+			title.Parent.RemoveChild(title)
 		}
-
-		purchase, err := parser.ParsePurchase(node)
-		if err != nil {
-			t.Errorf("Error parsing purchase: %s\n", err.Error())
-		} else {
-			fmt.Printf("purchase: %v\n", purchase)
+		if result, err = p.Extract(doc, US, PageProduct); err != nil {
+			t.Fatalf("Error extracting record: %s\n", err.Error())
 		}
+		current.Add(result)
+	}
 
-		content, err := parser.ParseContent(node)
-		if err != nil {
-			t.Errorf("Error parsing content: %s\n", err.Error())
-		} else {
-			fmt.Printf("content: %v\n", content)
+	group := current.Group(US, PageProduct)
+	if group == nil || group.Pages != 2 || group.Fields["title"].Rate() != 0.5 || group.Fields["asin"].Rate() != 1 {
+		t.Fatalf("Unexpected fill rates: %+v\n", group)
+	}
+
+	alerts := DetectDrift(baseline, current, 0.25)
+	if len(alerts) != 1 || alerts[0].Field != "title" || alerts[0].Baseline != 1 || alerts[0].Current != 0.5 {
+		t.Errorf("Unexpected alerts: %+v\n", alerts)
+	}
+	if alerts := DetectDrift(baseline, current, 0.5); len(alerts) != 0 {
+		t.Errorf("Unexpected alerts below the threshold: %+v\n", alerts)
+	}
+	if fill := group.Fields["coupon"]; fill == nil || fill.Tried != 2 || fill.Filled != 0 {
+		t.Errorf("Unexpected fill of a missing optional field: %+v\n", fill)
+	}
+
+	invalid := &Result{Region: US, PageType: PageProduct, fields: []string{"price"},
+		ValidationErrors: []FieldError{{Field: "price", Error: "invalid price"}}}
+	current.Add(invalid)
+	current.Fail(US, PageProduct)
+	if group.Pages != 4 || group.Failed != 1 || group.Fields["price"].Filled != 2 || group.Fields["price"].Tried != 3 ||
+		group.Fields["page"].Rate() != 0.75 {
+		t.Errorf("Unexpected fill rates: %+v\n", group)
+	}
+	alerts = DetectDrift(baseline, current, 0.2)
+	if len(alerts) != 3 || alerts[0].Field != "page" || alerts[1].Field != "price" || alerts[2].Field != "title" {
+		t.Errorf("Unexpected alerts: %+v\n", alerts)
+	}
+
+	undetected := NewFillRates()
+	undetected.Fail(US, "unknown")
+	if alerts := DetectDrift(baseline, undetected, 0.2); len(alerts) != 1 || alerts[0].PageType != "unknown" || alerts[0].Current != 0 {
+		t.Errorf("Unexpected alerts for pages that could not be extracted: %+v\n", alerts)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	checks := []struct {
+		check check
+		value string
+		score float64
+		valid bool
+	}{
+		{checkASIN, "B0ABCDEFGH", 1, true},
+		{checkASIN, "0306406152", 1, true},
+		{checkASIN, "080442957X", 1, true},
+		{checkASIN, "0306406153", 0, false},
+		{checkASIN, "B1ABCDEFGH", 0, false},
+		{checkStar, "4.5", 1, true},
+		{checkStar, "4,5", 1, true},
+		{checkStar, "0", 0.5, true},
+		{checkStar, "5.5", 0, false},
+		{checkStar, "-1", 0, false},
+		{checkRating, "1,234", 1, true},
+		{checkRating, "1.234", 1, true},
+		{checkRating, "1K+", 0.5, true},
+		{checkRating, "-3", 0, false},
+		{checkPrice, "$24.99", 1, true},
+		{checkPrice, "19,99 €", 1, true},
+		{checkPrice, "24.99", 0.8, true},
+		{checkPrice, "$0.00", 0, false},
+		{checkPrice, "-20%", 0, false},
+		{checkURL, "https://m.media-amazon.com/images/I/a.jpg", 1, true},
+		{checkURL, "/dp/B0ABCDEFGH", 0.8, true},
+		{checkURL, "javascript:void(0)", 0, false},
+		{checkURL, "not a url", 0, false},
+		{checkPage, "2", 1, true},
+		{checkPage, "0", 0, false},
+		{checkPage, "next", 0, false},
+	}
+	for _, c := range checks {
+		score, err := c.check(c.value)
+		if score != c.score || (err == nil) != c.valid {
+			t.Errorf("%q: unexpected score %v, error %v\n", c.value, score, err)
 		}
 	}
+
+	r := &Result{
+		Record: &model.ListingRecord{Items: []*model.ListingItem{
+			{ASIN: "B0ABCDEFGH", Price: "$9.99", Star: "4.5"},
+			{ASIN: "", Price: "$9.99", Star: "7"},
+		}},
+		Errors: []FieldError{{Field: "items[1].asin", Error: "empty value"}},
+		fields: []string{"items[0].asin", "items[0].price", "items[0].star", "items[1].asin", "items[1].price", "items[1].star"},
+	}
+	r.validate()
+	if len(r.ValidationErrors) != 1 || r.ValidationErrors[0].Field != "items[1].star" {
+		t.Errorf("Unexpected validation errors: %+v\n", r.ValidationErrors)
+	}
+	if r.FieldConfidence["items[0].asin"] != 1 || r.FieldConfidence["items[1].asin"] != 0 || r.FieldConfidence["items[1].star"] != 0 {
+		t.Errorf("Unexpected field confidence: %+v\n", r.FieldConfidence)
+	}
+	if r.Confidence != 4.0/6 {
+		t.Errorf("Unexpected confidence: %v\n", r.Confidence)
+	}
+
+	r = &Result{Record: &model.ProductRecord{ASIN: "B0ABCDEFGH", PrimePrice: "-20%", Color: "Black"}}
+	r.observe("asin", nil)
+	r.optional("prime_price")("-20%", nil)
+	r.optional("color")("Black", nil)
+	r.optional("coupon")("", errors.ErrorEmptyValue)
+	r.validate()
+	if len(r.ValidationErrors) != 1 || r.ValidationErrors[0].Field != "prime_price" {
+		t.Errorf("Unexpected validation errors: %+v\n", r.ValidationErrors)
+	}
+	if _, ok := r.FieldConfidence["coupon"]; ok || r.FieldConfidence["color"] != 1 || r.FieldConfidence["prime_price"] != 0 {
+		t.Errorf("Unexpected field confidence: %+v\n", r.FieldConfidence)
+	}
+	if r.Confidence != 2.0/3 {
+		t.Errorf("Unexpected confidence: %v\n", r.Confidence)
+	}
 }
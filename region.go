@@ -13,3 +13,20 @@ const (
 	DE_PREFIX = "https://www.amazon.de"
 	FR_PREFIX = "https://www.amazon.fr"
 )
+
+// RegionPrefix returns the URL prefix of the given region, or an empty
+// string if the region is not supported.
+func RegionPrefix(region string) string {
+	switch region {
+	case US:
+		return US_PREFIX
+	case UK:
+		return UK_PREFIX
+	case DE:
+		return DE_PREFIX
+	case FR:
+		return FR_PREFIX
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,44 @@
+package goamzparser
+
+import (
+	"sort"
+
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+// MergeReviews merges the review batches of several pages or crawls into
+// one set keyed by review id, newest first. A review seen more than once
+// keeps the values of the last batch it appeared in. Reviews without an id
+// are kept as they are.
+func MergeReviews(batches ...[]*model.Review) []*model.Review {
+	index := make(map[string]int)
+	var reviews []*model.Review
+	for _, batch := range batches {
+		for _, review := range batch {
+			if review == nil {
+				continue
+			}
+			if review.ID == "" || review.ID == "unknown" {
+				reviews = append(reviews, review)
+				continue
+			}
+			if i, ok := index[review.ID]; ok {
+				reviews[i] = review
+				continue
+			}
+			index[review.ID] = len(reviews)
+			reviews = append(reviews, review)
+		}
+	}
+
+	sort.SliceStable(reviews, func(i, j int) bool {
+		a, errA := utils.ParseDate(reviews[i].Date)
+		b, errB := utils.ParseDate(reviews[j].Date)
+		if errA != nil || errB != nil {
+			return errA == nil && errB != nil
+		}
+		return a.After(b)
+	})
+	return reviews
+}
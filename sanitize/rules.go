@@ -0,0 +1,264 @@
+package sanitize
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	// sessionRegex matches session, order and ubid ids, e.g. "123-4567890-1234567".
+	sessionRegex = regexp.MustCompile(`\b\d{3}-\d{7}-\d{7}\b`)
+	emailRegex   = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	// secretRegex matches the names of attributes, inputs and meta tags
+	// carrying tokens or customer identifiers.
+	secretRegex = regexp.MustCompile(`(?i)csrf|token|session|ubid|nonce|customer-?id|x-main|at-main|sess-at|cart-?id`)
+	// secretJSONRegex matches the string members of secret names in scripts.
+	secretJSONRegex = regexp.MustCompile(`(?i)("[\w-]*(?:csrf|token|session|ubid|nonce|customer-?id)[\w-]*"\s*:\s*)"[^"]*"`)
+)
+
+// personalIDs are the ids of the elements showing the signed in customer's
+// name or delivery address.
+var personalIDs = map[string]bool{
+	"nav-link-accountList-nav-line-1": true,
+	"nav-greeting-name":               true,
+	"glow-ingress-line1":              true,
+	"glow-ingress-line2":              true,
+	"nav-your-amazon-text":            true,
+}
+
+// blobMarkers identify the scripts carrying the twister and image JSON
+// blobs, which are kept.
+var blobMarkers = []string{
+	"twister", "asinVariationValues", "dimensionValues", "colorImages", "ImageBlock", "imageGalleryData",
+}
+
+// removableTags are the elements the parsers do not read.
+var removableTags = map[string]bool{
+	"style": true, "link": true, "noscript": true, "iframe": true, "svg": true,
+	"template": true, "object": true, "embed": true, "canvas": true, "meta": true,
+}
+
+// keptAttrs are the attributes the parsers select on, which are not tried.
+var keptAttrs = map[string]bool{
+	"id": true, "class": true, "href": true, "src": true, "lang": true, "name": true,
+	"value": true, "type": true, "alt": true, "title": true, "aria-label": true,
+	"data-asin": true, "data-index": true, "data-uuid": true, "data-hook": true,
+	"data-a-dynamic-image": true, "charset": true,
+}
+
+// anonymize replaces the personal and session data of the document.
+func anonymize(doc *html.Node) {
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; {
+			next := c.NextSibling
+			if c.Type == html.CommentNode {
+				n.RemoveChild(c)
+			} else {
+				walk(c)
+			}
+			c = next
+		}
+
+		switch n.Type {
+		case html.TextNode:
+			n.Data = redact(n.Data)
+			if n.Parent != nil && n.Parent.Data == "script" {
+				n.Data = secretJSONRegex.ReplaceAllString(n.Data, `$1""`)
+			}
+		case html.ElementNode:
+			secret := false
+			for i, attr := range n.Attr {
+				n.Attr[i].Val = redact(attr.Val)
+				switch {
+				case secretRegex.MatchString(attr.Key):
+					n.Attr[i].Val = ""
+				case (attr.Key == "name" || attr.Key == "id" || attr.Key == "property") && secretRegex.MatchString(attr.Val):
+					secret = n.Data == "input" || n.Data == "meta"
+				}
+			}
+			for i, attr := range n.Attr {
+				if secret && (attr.Key == "value" || attr.Key == "content") {
+					n.Attr[i].Val = ""
+				}
+				if attr.Key == "id" && personalIDs[attr.Val] {
+					replaceText(n, "Customer")
+				}
+			}
+		}
+	}
+	walk(doc)
+}
+
+// redact replaces the session ids and e-mail addresses in s.
+func redact(s string) string {
+	s = sessionRegex.ReplaceAllString(s, "000-0000000-0000000")
+	return emailRegex.ReplaceAllString(s, "customer@example.com")
+}
+
+// replaceText replaces the text of n with s.
+func replaceText(n *html.Node, s string) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		n.RemoveChild(c)
+		c = next
+	}
+	n.AppendChild(&html.Node{Type: html.TextNode, Data: s})
+}
+
+// removeNodes returns the removal of each script, except the JSON blobs,
+// and of each element of the removable tags.
+func removeNodes(doc *html.Node) []change {
+	var changes []change
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+		if n.Type != html.ElementNode || n.Parent == nil {
+			return
+		}
+		if n.Data == "script" && isBlob(n) || n.Data != "script" && !removableTags[n.Data] {
+			return
+		}
+
+		parent := n.Parent
+		var next *html.Node
+		changes = append(changes, change{
+			apply: func() {
+				next = n.NextSibling
+				parent.RemoveChild(n)
+			},
+			undo: func() {
+				parent.InsertBefore(n, next)
+			},
+		})
+	}
+	walk(doc)
+	return changes
+}
+
+// isBlob reports whether the script carries a twister or image JSON blob.
+func isBlob(script *html.Node) bool {
+	for c := script.FirstChild; c != nil; c = c.NextSibling {
+		for _, marker := range blobMarkers {
+			if strings.Contains(c.Data, marker) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// removeAttrs returns the removal, from all elements, of each attribute
+// name that is not kept.
+func removeAttrs(doc *html.Node) []change {
+	var names []string
+	elements := make(map[string][]*html.Node)
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			for _, attr := range n.Attr {
+				if keptAttrs[attr.Key] {
+					continue
+				}
+				if _, ok := elements[attr.Key]; !ok {
+					names = append(names, attr.Key)
+				}
+				elements[attr.Key] = append(elements[attr.Key], n)
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	changes := make([]change, 0, len(names))
+	for _, name := range names {
+		name, nodes := name, elements[name]
+		saved := make([][]html.Attribute, len(nodes))
+		changes = append(changes, change{
+			apply: func() {
+				for i, n := range nodes {
+					saved[i] = n.Attr
+					attrs := make([]html.Attribute, 0, len(n.Attr))
+					for _, attr := range n.Attr {
+						if attr.Key != name {
+							attrs = append(attrs, attr)
+						}
+					}
+					n.Attr = attrs
+				}
+			},
+			undo: func() {
+				for i, n := range nodes {
+					n.Attr = saved[i]
+				}
+			},
+		})
+	}
+	return changes
+}
+
+// collapseSpace returns the collapse of whitespace only text nodes, and of
+// the leading and trailing whitespace of the other text nodes, outside of
+// scripts and preformatted elements.
+func collapseSpace(doc *html.Node) []change {
+	var blank, padded []*html.Node
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && (n.Data == "script" || n.Data == "pre" || n.Data == "textarea") {
+			return
+		}
+		if n.Type == html.TextNode {
+			if strings.TrimSpace(n.Data) == "" {
+				blank = append(blank, n)
+			} else if strings.TrimSpace(n.Data) != n.Data {
+				padded = append(padded, n)
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	collapse := func(nodes []*html.Node, space func(string) string) change {
+		saved := make([]string, len(nodes))
+		return change{
+			apply: func() {
+				for i, n := range nodes {
+					saved[i] = n.Data
+					n.Data = space(n.Data)
+				}
+			},
+			undo: func() {
+				for i, n := range nodes {
+					n.Data = saved[i]
+				}
+			},
+		}
+	}
+	return []change{
+		collapse(blank, func(s string) string {
+			if strings.Contains(s, "\n") {
+				return "\n"
+			}
+			return " "
+		}),
+		collapse(padded, func(s string) string {
+			trimmed := strings.TrimSpace(s)
+			if strings.TrimLeftFunc(s, unicode.IsSpace) != s {
+				trimmed = " " + trimmed
+			}
+			if strings.TrimRightFunc(s, unicode.IsSpace) != s {
+				trimmed += " "
+			}
+			return trimmed
+		}),
+	}
+}
@@ -0,0 +1,117 @@
+// Package sanitize turns saved Amazon pages into fixtures that can be
+// shared.
+//
+// Personal and session data, such as the greeting and delivery address of
+// the signed in customer, session and order ids, e-mail addresses and CSRF
+// or cart tokens, are always replaced. The page is then shrunk by removing
+// the scripts, except the twister and image JSON blobs, styles, comments
+// and attributes the parsers do not read. A removal is only kept if the
+// parsers still extract the same result, and the sanitized page as a whole
+// is verified the same way.
+package sanitize
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+)
+
+// Sanitize returns the sanitized HTML of the given page. The region and
+// page type are detected like goamzparser.Parser.Extract if empty. It
+// fails if the page cannot be extracted or if the sanitized page does not
+// extract to the same result.
+func Sanitize(parser *goamzparser.Parser, page []byte, region, pageType string) ([]byte, error) {
+	doc, err := htmlquery.Parse(bytes.NewReader(page))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &sanitizer{parser: parser, region: region, pageType: pageType}
+	if s.want, err = s.extract(doc); err != nil {
+		return nil, err
+	}
+
+	anonymize(doc)
+	s.doc = doc
+	if !s.same() {
+		return nil, fmt.Errorf("'%v' error, anonymizing the page changed the parsed result", "sanitize")
+	}
+
+	s.try(removeNodes(doc))
+	s.try(removeAttrs(doc))
+	s.try(collapseSpace(doc))
+
+	var out bytes.Buffer
+	if err := html.Render(&out, doc); err != nil {
+		return nil, err
+	}
+
+	sanitized, err := htmlquery.Parse(bytes.NewReader(out.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	got, err := s.extract(sanitized)
+	if err != nil || !bytes.Equal(got, s.want) {
+		return nil, fmt.Errorf("'%v' error, the sanitized page changed the parsed result", "sanitize")
+	}
+	return out.Bytes(), nil
+}
+
+// change is an undoable modification of the document.
+type change struct {
+	apply func()
+	undo  func()
+}
+
+type sanitizer struct {
+	parser   *goamzparser.Parser
+	region   string
+	pageType string
+	doc      *html.Node
+	want     []byte
+}
+
+// extract returns the JSON encoded result of the given document.
+func (s *sanitizer) extract(doc *html.Node) ([]byte, error) {
+	result, err := s.parser.Extract(doc, s.region, s.pageType)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(result)
+}
+
+// same reports whether the document still extracts to the original result.
+func (s *sanitizer) same() bool {
+	got, err := s.extract(s.doc)
+	return err == nil && bytes.Equal(got, s.want)
+}
+
+// try applies the changes that leave the result unchanged. The changes are
+// applied together and split in halves until the ones that change the
+// result are isolated and undone.
+func (s *sanitizer) try(changes []change) {
+	if len(changes) == 0 {
+		return
+	}
+	for _, c := range changes {
+		c.apply()
+	}
+	if s.same() {
+		return
+	}
+	for i := len(changes) - 1; i >= 0; i-- {
+		changes[i].undo()
+	}
+	if len(changes) == 1 {
+		return
+	}
+
+	mid := len(changes) / 2
+	s.try(changes[:mid])
+	s.try(changes[mid:])
+}
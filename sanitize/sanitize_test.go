@@ -0,0 +1,102 @@
+package sanitize
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/antchfx/htmlquery"
+
+	goamzparser "github.com/microsuite/go-amz-parser"
+)
+
+const noise = `
+<!-- session 123-4567890-1234567 -->
+<meta name="encrypted-slate-token" content="AnYxSecretToken=="/>
+<link rel="stylesheet" href="https://m.media-amazon.com/images/I/all.css"/>
+<style>.a-price { color: #B12704; } .nav-sprite { background: url(sprite.png); }</style>
+<script>window.ue_sid = '123-4567890-1234567'; (function () { var csm = {}; csm.start = +new Date(); })();</script>
+<script type="a-state" data-a-state='{"key":"cart"}'>{"csrfToken":"hFp9eXtOkEN","customerId":"A2CUSTOMER"}</script>
+<script>P.when('A').register("ImageBlockATF", function () { return {"colorImages": {}, "sessionToken": "zZ9BlobSecret"}; });</script>
+<div id="nav-belt" style="display: block" onclick="void(0)">
+ <span id="nav-link-accountList-nav-line-1" class="nav-line-1">Hello, Alice Smith</span>
+ <div id="glow-ingress-block"><span id="glow-ingress-line1">Deliver to Alice</span><span id="glow-ingress-line2">Springfield 12345</span></div>
+ <form id="nav-cart-form"><input type="hidden" name="anti-csrftoken-a2z" value="hJk2LmNoPq"/><input type="hidden" name="session-id" value="123-4567890-1234567"/></form>
+ <a href="mailto:alice.smith@example.org">alice.smith@example.org</a>
+ <svg width="10" height="10"><path d="M0 0h10v10H0z"/></svg>
+ <noscript><img src="https://fls-na.amazon.com/1/batch/1/OP/ATVPDKIKX0DER:123-4567890-1234567:ABCDEF$uedata=s:/rd/uedata"/></noscript>
+</div>
+`
+
+func TestSanitize(t *testing.T) {
+	p := goamzparser.NewParser()
+
+	original, err := os.ReadFile("../testdata/en-us/product/basic.html")
+	if err != nil {
+		t.Fatalf("Error reading page: %s\n", err.Error())
+	}
+	page := bytes.Replace(original, []byte(`<div id="a-page">`), []byte(`<div id="a-page">`+noise), 1)
+
+	sanitized, err := Sanitize(p, page, "", "")
+	if err != nil {
+		t.Fatalf("Error sanitizing page: %s\n", err.Error())
+	}
+	if len(sanitized) >= len(page) {
+		t.Errorf("Sanitized page not smaller: %v >= %v bytes\n", len(sanitized), len(page))
+	}
+
+	for _, secret := range []string{"123-4567890-1234567", "Alice", "Springfield", "alice.smith", "hJk2LmNoPq", "hFp9eXtOkEN", "AnYxSecretToken", "zZ9BlobSecret", "ue_sid", ".a-price {", "onclick", "<svg", "<!--"} {
+		if strings.Contains(string(sanitized), secret) {
+			t.Errorf("Sanitized page still contains %q\n", secret)
+		}
+	}
+	if !strings.Contains(string(sanitized), `"asinVariationValues"`) || !strings.Contains(string(sanitized), `"colorImages"`) {
+		t.Errorf("Sanitized page lost the twister or image blob\n")
+	}
+
+	if want, got := extract(t, p, page), extract(t, p, sanitized); want != got {
+		t.Errorf("Sanitized page parses differently:\n%v\n%v\n", want, got)
+	}
+}
+
+func TestSanitizeTestdata(t *testing.T) {
+	p := goamzparser.NewParser()
+
+	files, err := filepath.Glob("../testdata/*/*/*.html")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("No testdata pages found: %v\n", err)
+	}
+	for _, file := range files {
+		page, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Error reading page: %s\n", err.Error())
+		}
+		sanitized, err := Sanitize(p, page, "", "")
+		if err != nil {
+			t.Errorf("%v: error sanitizing page: %s\n", file, err.Error())
+			continue
+		}
+		if want, got := extract(t, p, page), extract(t, p, sanitized); want != got {
+			t.Errorf("%v: sanitized page parses differently\n", file)
+		}
+	}
+}
+
+func extract(t *testing.T, p *goamzparser.Parser, page []byte) string {
+	doc, err := htmlquery.Parse(bytes.NewReader(page))
+	if err != nil {
+		t.Fatalf("Error parsing page: %s\n", err.Error())
+	}
+	result, err := p.Extract(doc, "", "")
+	if err != nil {
+		t.Fatalf("Error extracting record: %s\n", err.Error())
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Error encoding result: %s\n", err.Error())
+	}
+	return string(data)
+}
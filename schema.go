@@ -0,0 +1,105 @@
+package goamzparser
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+//go:generate sh -c "go run ./cmd/amzparse schema > schema/v1/result.schema.json"
+
+// JSONSchema returns the JSON Schema of Result, the output contract of
+// Extract, the amzparse command and the HTTP service. Fields may be added
+// within a schema version but never renamed or removed.
+func JSONSchema() ([]byte, error) {
+	g := &schemaGenerator{defs: make(map[string]interface{})}
+	root := g.object(reflect.TypeOf(Result{}))
+
+	var records []interface{}
+	for _, record := range []interface{}{model.ProductRecord{}, model.ListingRecord{}, model.ReviewRecord{}} {
+		records = append(records, g.schema(reflect.TypeOf(record)))
+	}
+	root["properties"].(map[string]interface{})["record"] = map[string]interface{}{"oneOf": records}
+
+	root["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	root["$id"] = "https://github.com/microsuite/go-amz-parser/schema/" + model.SchemaVersion + "/result.schema.json"
+	root["title"] = "go-amz-parser result " + model.SchemaVersion
+	root["$defs"] = g.defs
+	return json.MarshalIndent(root, "", "  ")
+}
+
+// schemaGenerator builds JSON Schemas from the json tags of Go types.
+type schemaGenerator struct {
+	defs map[string]interface{}
+}
+
+// schema returns the schema of t, referencing structs by their definition.
+func (g *schemaGenerator) schema(t reflect.Type) map[string]interface{} {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.schema(t.Elem())
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": g.schema(t.Elem())}
+	case reflect.Array:
+		return map[string]interface{}{"type": "array", "items": g.schema(t.Elem()), "minItems": t.Len(), "maxItems": t.Len()}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": g.schema(t.Elem())}
+	case reflect.Struct:
+		if _, ok := g.defs[t.Name()]; !ok {
+			g.defs[t.Name()] = nil
+			g.defs[t.Name()] = g.object(t)
+		}
+		return map[string]interface{}{"$ref": "#/$defs/" + t.Name()}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// object returns the schema of the struct t. Fields without omitempty are
+// required, and nullable if they are pointers, slices or maps.
+func (g *schemaGenerator) object(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := strings.Split(f.Tag.Get("json"), ",")
+		if !f.IsExported() || tag[0] == "-" {
+			continue
+		}
+
+		name := tag[0]
+		if name == "" {
+			name = f.Name
+		}
+		omitempty := len(tag) > 1 && tag[1] == "omitempty"
+
+		s := g.schema(f.Type)
+		switch f.Type.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Map:
+			if !omitempty {
+				s = map[string]interface{}{"anyOf": []interface{}{s, map[string]interface{}{"type": "null"}}}
+			}
+		}
+		properties[name] = s
+		if !omitempty {
+			required = append(required, name)
+		}
+	}
+
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+var countRegex = regexp.MustCompile(`\d+(?:[.,\s\x{a0}\x{202f}]\d{3})*`)
+
+// ParseResultCount parses a result count line such as
+// "1-48 of over 10,000 results for" or "1-48 von über 10.000 Ergebnissen".
+// overWords are the localized words used for an approximated total.
+func ParseResultCount(s string, overWords ...string) (*model.ResultCount, error) {
+	var numbers []int
+	for _, match := range countRegex.FindAllString(s, -1) {
+		digits := strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, match)
+
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, n)
+	}
+
+	count := &model.ResultCount{}
+	for _, word := range overWords {
+		if word != "" && strings.Contains(s, word) {
+			count.Over = true
+		}
+	}
+	switch len(numbers) {
+	case 0:
+		return nil, fmt.Errorf("'%v' error, no result count found", s)
+	case 1:
+		count.Start, count.End, count.Total = 1, numbers[0], numbers[0]
+	case 2:
+		count.Start, count.End, count.Total = numbers[0], numbers[1], numbers[1]
+	default:
+		count.Start, count.End, count.Total = numbers[0], numbers[1], numbers[2]
+	}
+	return count, nil
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// monthNames maps the localized month names to their English names.
+var monthNames = strings.NewReplacer(
+	"Januar", "January", "Februar", "February", "März", "March", "Mai", "May",
+	"Juni", "June", "Juli", "July", "Oktober", "October", "Dezember", "December",
+	"janvier", "January", "février", "February", "mars", "March", "avril", "April",
+	"mai", "May", "juin", "June", "juillet", "July", "août", "August",
+	"septembre", "September", "octobre", "October", "novembre", "November", "décembre", "December",
+)
+
+var dateLayouts = []string{
+	"January 2, 2006",
+	"Jan 2, 2006",
+	"2 January 2006",
+	"2. January 2006",
+}
+
+// ParseDate parses a localized review date such as "May 3, 2024",
+// "3 May 2024", "3. Mai 2024" or "3 mai 2024".
+func ParseDate(s string) (time.Time, error) {
+	date := strings.Join(strings.Fields(monthNames.Replace(s)), " ")
+	date = strings.Replace(date, "1er ", "1 ", 1)
+
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("'%v' error, unknown date format", s)
+}
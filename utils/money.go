@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/microsuite/go-amz-parser/model"
+)
+
+var (
+	amountRegex  = regexp.MustCompile(`\d[\d.,]*`)
+	percentRegex = regexp.MustCompile(`(\d+(?:[.,]\d+)?)\s*%`)
+)
+
+// FindCurrency returns the ISO 4217 code of the currency symbol found in s.
+func FindCurrency(s string) string {
+	switch {
+	case strings.Contains(s, "$"):
+		return "USD"
+	case strings.Contains(s, "£"):
+		return "GBP"
+	case strings.Contains(s, "€"), strings.Contains(s, "EUR"):
+		return "EUR"
+	case strings.Contains(s, "￥"):
+		return "JPY"
+	default:
+		return ""
+	}
+}
+
+// ParseAmount parses the first number in s, accepting both "1,234.56" and
+// "1.234,56" notations as well as space separated thousands.
+func ParseAmount(s string) (float64, error) {
+	s = strings.NewReplacer(" ", "", "\u00a0", "", "\u202f", "").Replace(s)
+	num := strings.TrimRight(amountRegex.FindString(s), ".,")
+	if num == "" {
+		return 0, fmt.Errorf("'%v' error, no amount found", s)
+	}
+
+	comma, dot := strings.LastIndex(num, ","), strings.LastIndex(num, ".")
+	switch {
+	case comma >= 0 && dot >= 0:
+		if comma > dot {
+			num = strings.ReplaceAll(num, ".", "")
+			num = strings.ReplaceAll(num, ",", ".")
+		} else {
+			num = strings.ReplaceAll(num, ",", "")
+		}
+	case comma >= 0:
+		if strings.Count(num, ",") == 1 && len(num)-comma-1 != 3 {
+			num = strings.ReplaceAll(num, ",", ".")
+		} else {
+			num = strings.ReplaceAll(num, ",", "")
+		}
+	case dot >= 0:
+		if strings.Count(num, ".") > 1 || len(num)-dot-1 == 3 {
+			num = strings.ReplaceAll(num, ".", "")
+		}
+	}
+	return strconv.ParseFloat(num, 64)
+}
+
+// ParseMoney parses a price text such as "$1,299.99" or "12,99 €".
+func ParseMoney(s string) (*model.Money, error) {
+	currency := FindCurrency(s)
+	if currency == "" {
+		return nil, fmt.Errorf("'%v' error, no currency found", s)
+	}
+
+	amount, err := ParseAmount(s)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Money{Amount: amount, Currency: currency}, nil
+}
+
+// ParsePercent parses the first percentage in s, e.g. "-25%" or "10 %".
+func ParsePercent(s string) (float64, error) {
+	matches := percentRegex.FindStringSubmatch(s)
+	if len(matches) < 2 {
+		return 0, fmt.Errorf("'%v' error, no percentage found", s)
+	}
+	return strconv.ParseFloat(strings.ReplaceAll(matches[1], ",", "."), 64)
+}
+
+// ParseCoupon parses a coupon label such as "Apply 10% coupon" or
+// "Save £5.00 with coupon". It returns nil if the label has no discount.
+func ParseCoupon(s string) *model.Coupon {
+	s = strings.TrimSpace(s)
+	if percent, err := ParsePercent(s); err == nil {
+		return &model.Coupon{Percent: percent, Text: s}
+	}
+	if money, err := ParseMoney(s); err == nil {
+		return &model.Coupon{Amount: money, Text: s}
+	}
+	return nil
+}
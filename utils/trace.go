@@ -0,0 +1,171 @@
+package utils
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/net/html"
+)
+
+// Attempt is an XPath expression evaluated by a parser method while tracing.
+// Accepted reports whether FindNodes returned the matched nodes, which it
+// does not for no match or for several matches of a single node lookup.
+type Attempt struct {
+	Expr     string `json:"expr"`
+	Matches  int    `json:"matches"`
+	Accepted bool   `json:"accepted"`
+}
+
+// Call is an invocation of a parser method, e.g. "USProductParser.ParsePrice",
+// with the expressions it tried in order.
+//
+// Winners are the accepted expressions in order. A fallback loop stops at
+// its first accepted expression, so each winner ends one fallback group,
+// and methods composed of several lookups, such as ParsePriceInfo, have
+// one winner per lookup that succeeded.
+type Call struct {
+	Method   string    `json:"method"`
+	Attempts []Attempt `json:"attempts"`
+	Winners  []string  `json:"winners,omitempty"`
+}
+
+// Trace records the expressions FindNodes evaluates on a document.
+type Trace struct {
+	mu    sync.Mutex
+	calls []*Call
+	node  *html.Node
+	refs  int // guarded by tracesMu
+}
+
+var (
+	tracing  atomic.Int32
+	tracesMu sync.Mutex // serializes StartTrace and StopTrace
+	traces   sync.Map   // root *html.Node -> *Trace
+)
+
+// StartTrace starts recording the expressions evaluated on the given
+// document and its subtrees until StopTrace is called. Tracing a document
+// that is already traced returns the same trace, which then records the
+// calls of both callers until each has called StopTrace.
+func StartTrace(doc *html.Node) *Trace {
+	tracesMu.Lock()
+	defer tracesMu.Unlock()
+
+	if t, ok := traces.Load(root(doc)); ok {
+		t.(*Trace).refs++
+		return t.(*Trace)
+	}
+	t := &Trace{refs: 1}
+	traces.Store(root(doc), t)
+	tracing.Add(1)
+	return t
+}
+
+// StopTrace stops recording the expressions evaluated on the given document
+// once every StartTrace of it has been stopped.
+func StopTrace(doc *html.Node) {
+	tracesMu.Lock()
+	defer tracesMu.Unlock()
+
+	t, ok := traces.Load(root(doc))
+	if !ok {
+		return
+	}
+	if t.(*Trace).refs--; t.(*Trace).refs == 0 {
+		traces.Delete(root(doc))
+		tracing.Add(-1)
+	}
+}
+
+// Calls returns the recorded parser method calls.
+func (t *Trace) Calls() []*Call {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, call := range t.calls {
+		call.Winners = nil
+		for _, attempt := range call.Attempts {
+			if attempt.Accepted && !call.Won(attempt.Expr) {
+				call.Winners = append(call.Winners, attempt.Expr)
+			}
+		}
+	}
+	return t.calls
+}
+
+// Won reports whether expr is one of the winners of the call.
+func (c *Call) Won(expr string) bool {
+	for _, winner := range c.Winners {
+		if winner == expr {
+			return true
+		}
+	}
+	return false
+}
+
+// record adds an attempt to the call of the parser method evaluating expr
+// on node. Consecutive attempts of a method on the same node belong to the
+// same call.
+func (t *Trace) record(node *html.Node, expr string, matches int, accepted bool) {
+	method := parserMethod()
+	if method == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var call *Call
+	if n := len(t.calls); n > 0 && t.calls[n-1].Method == method && t.node == node {
+		call = t.calls[n-1]
+	} else {
+		call = &Call{Method: method}
+		t.calls = append(t.calls, call)
+		t.node = node
+	}
+	call.Attempts = append(call.Attempts, Attempt{Expr: expr, Matches: matches, Accepted: accepted})
+}
+
+// traceFindNodes records the evaluation of expr on node if its document
+// is traced.
+func traceFindNodes(node *html.Node, expr string, matches int, accepted bool) {
+	if tracing.Load() == 0 {
+		return
+	}
+	if t, ok := traces.Load(root(node)); ok {
+		t.(*Trace).record(node, expr, matches, accepted)
+	}
+}
+
+// parserMethod returns the innermost Parse method of a parser type on the
+// call stack, e.g. "USProductParser.ParsePrice".
+func parserMethod() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		name := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		if index := strings.Index(name, ").Parse"); index >= 0 {
+			receiver := name[:index]
+			receiver = receiver[strings.LastIndex(receiver, "*")+1:]
+			method := name[index+2:]
+			if dot := strings.Index(method, "."); dot >= 0 {
+				method = method[:dot]
+			}
+			return receiver + "." + method
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// root returns the document node of the given node.
+func root(node *html.Node) *html.Node {
+	for node != nil && node.Parent != nil {
+		node = node.Parent
+	}
+	return node
+}
@@ -15,6 +15,7 @@ func FindNodes(doc *html.Node, expr string, multi bool) ([]*html.Node, error) {
 	if err != nil {
 		return nil, fmt.Errorf("'%v' error, %v", expr, err)
 	}
+	traceFindNodes(doc, expr, len(nodes), len(nodes) == 1 || len(nodes) > 1 && multi)
 
 	if len(nodes) == 0 {
 		return nil, fmt.Errorf("'%v' error, no nodes selected", expr)
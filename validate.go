@@ -0,0 +1,189 @@
+package goamzparser
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/microsuite/go-amz-parser/errors"
+	"github.com/microsuite/go-amz-parser/model"
+	"github.com/microsuite/go-amz-parser/utils"
+)
+
+var (
+	asinRegex   = regexp.MustCompile(`^B0[A-Z0-9]{8}$`)
+	isbn10Regex = regexp.MustCompile(`^[0-9]{9}[0-9X]$`)
+	countRegex  = regexp.MustCompile(`^[0-9]{1,3}(?:[,.\s\x{a0}\x{202f}]?[0-9]{3})*$`)
+)
+
+// check validates the value of a field and returns its confidence, from 0
+// to 1, or an error if the value is invalid.
+type check func(value string) (float64, error)
+
+// validate checks the typed fields of the record and sets the confidence
+// of the result. The confidence of a field is 0 if it could not be parsed
+// or is invalid, lower than 1 if it is valid but ambiguous, e.g. a relative
+// url or a price without currency, and 1 otherwise. The confidence of the
+// result is the mean confidence of its fields. Optional fields absent from
+// the page, such as a coupon, are not scored.
+func (r *Result) validate() {
+	scores := make(map[string]float64)
+	for _, name := range r.fields {
+		if !r.missing[name] {
+			scores[name] = 1
+		}
+	}
+	for _, e := range r.Errors {
+		scores[e.Field] = 0
+	}
+
+	field := func(name, value string, c check) {
+		if value == "" || value == "unknown" {
+			return
+		}
+		score, err := c(value)
+		if err != nil {
+			r.ValidationErrors = append(r.ValidationErrors, FieldError{Field: name, Error: err.Error()})
+			score = 0
+		}
+		if current, ok := scores[name]; !ok || score < current {
+			scores[name] = score
+		}
+	}
+
+	switch record := r.Record.(type) {
+	case *model.ProductRecord:
+		field("asin", record.ASIN, checkASIN)
+		field("price", record.Price, checkPrice)
+		field("prime_price", record.PrimePrice, checkPrice)
+		field("star", record.Star, checkStar)
+		field("rating", record.Rating, checkRating)
+		field("img", record.Img, checkURL)
+	case *model.ListingRecord:
+		field("current_page", record.CurrentPage, checkPage)
+		field("max_page", record.MaxPage, checkPage)
+		field("next_page_url", record.NextPageURL, checkURL)
+		for i, item := range record.Items {
+			name := itemField(i)
+			field(name("asin"), item.ASIN, checkASIN)
+			field(name("price"), item.Price, checkPrice)
+			field(name("star"), item.Star, checkStar)
+			field(name("rating"), item.Rating, checkRating)
+			field(name("img"), item.Img, checkURL)
+		}
+	case *model.ReviewRecord:
+		for i, review := range record.Reviews {
+			name := func(name string) string {
+				return fmt.Sprintf("reviews[%v].%v", i, name)
+			}
+			field(name("star"), strconv.FormatFloat(review.Star, 'f', -1, 64), checkStar)
+			field(name("permalink"), review.Permalink, checkURL)
+			field(name("reviewer_link"), review.ReviewerLink, checkURL)
+			for j, image := range review.Images {
+				field(name(fmt.Sprintf("images[%v]", j)), image, checkURL)
+			}
+		}
+	}
+
+	r.FieldConfidence = scores
+	r.Confidence = 0
+	if len(scores) > 0 {
+		names := make([]string, 0, len(scores))
+		for name := range scores {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		sum := 0.0
+		for _, name := range names {
+			sum += scores[name]
+		}
+		r.Confidence = sum / float64(len(scores))
+	}
+}
+
+// checkASIN accepts an ASIN such as "B0ABCDEFGH" or the ISBN-10 books are
+// listed under.
+func checkASIN(value string) (float64, error) {
+	if asinRegex.MatchString(value) {
+		return 1, nil
+	}
+	if isbn10Regex.MatchString(value) {
+		sum := 0
+		for i, c := range value {
+			digit := int(c - '0')
+			if c == 'X' {
+				digit = 10
+			}
+			sum += (10 - i) * digit
+		}
+		if sum%11 == 0 {
+			return 1, nil
+		}
+	}
+	return 0, fmt.Errorf("'%v' error, %w", value, errors.ErrorInvalidASIN)
+}
+
+// checkStar accepts an average star from 0 to 5. A star of 0 is usually a
+// missing rating and has a lower confidence.
+func checkStar(value string) (float64, error) {
+	star, err := utils.ParseAmount(value)
+	if err != nil || strings.HasPrefix(value, "-") || star > 5 {
+		return 0, fmt.Errorf("'%v' error, %w", value, errors.ErrorInvalidStar)
+	}
+	if star == 0 {
+		return 0.5, nil
+	}
+	return 1, nil
+}
+
+// checkRating accepts a non-negative ratings count such as "1,234". An
+// abbreviated count such as "1K+" has a lower confidence.
+func checkRating(value string) (float64, error) {
+	if countRegex.MatchString(value) {
+		return 1, nil
+	}
+	if _, err := utils.ParseAmount(value); err == nil && !strings.HasPrefix(value, "-") {
+		return 0.5, nil
+	}
+	return 0, fmt.Errorf("'%v' error, %w", value, errors.ErrorInvalidRating)
+}
+
+// checkPage accepts a page number from 1.
+func checkPage(value string) (float64, error) {
+	page, err := strconv.Atoi(value)
+	if err != nil || page < 1 {
+		return 0, fmt.Errorf("'%v' error, %w", value, errors.ErrorInvalidPage)
+	}
+	return 1, nil
+}
+
+// checkPrice accepts a positive price. A price without currency has a
+// lower confidence.
+func checkPrice(value string) (float64, error) {
+	amount, err := utils.ParseAmount(value)
+	if err != nil || amount <= 0 || strings.HasPrefix(value, "-") {
+		return 0, fmt.Errorf("'%v' error, %w", value, errors.ErrorInvalidPrice)
+	}
+	if utils.FindCurrency(value) == "" {
+		return 0.8, nil
+	}
+	return 1, nil
+}
+
+// checkURL accepts an absolute http or https url. A url relative to the
+// marketplace domain has a lower confidence.
+func checkURL(value string) (float64, error) {
+	u, err := url.Parse(value)
+	switch {
+	case err != nil || strings.ContainsAny(value, " \t\n"):
+	case (u.Scheme == "http" || u.Scheme == "https") && u.Host != "":
+		return 1, nil
+	case u.Scheme == "" && u.Host == "" && strings.HasPrefix(u.Path, "/"):
+		return 0.8, nil
+	}
+	return 0, fmt.Errorf("'%v' error, %w", value, errors.ErrorInvalidURL)
+}